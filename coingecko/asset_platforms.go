@@ -0,0 +1,97 @@
+package coingecko
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// AssetPlatformsService handles the Asset Platforms endpoint for CoinGecko API
+type AssetPlatformsService struct {
+	client *Client
+}
+
+// AssetPlatform is a blockchain network tokens are issued on, e.g. "ethereum".
+// ChainIdentifier is the EVM chain ID, nil for non-EVM platforms.
+type AssetPlatform struct {
+	ID              string `json:"id"`
+	ChainIdentifier *int64 `json:"chain_identifier"`
+	Name            string `json:"name"`
+	ShortName       string `json:"shortname"`
+}
+
+// AssetPlatforms is a list of asset platforms
+type AssetPlatforms []AssetPlatform
+
+// PlatformID returns the id of the asset platform of an EVM chain ID
+func (p AssetPlatforms) PlatformID(chainID int64) (string, bool) {
+	for _, platform := range p {
+		if platform.ChainIdentifier != nil && *platform.ChainIdentifier == chainID {
+			return platform.ID, true
+		}
+	}
+	return "", false
+}
+
+// AssetPlatformsOptions are the optional query parameters of the asset platforms endpoint
+type AssetPlatformsOptions struct {
+	// Only return platforms supporting the given feature, see AssetPlatformsFilterNFT
+	Filter string `url:"filter,omitempty"`
+}
+
+// AssetPlatformsFilterNFT lists only platforms supporting NFTs
+const AssetPlatformsFilterNFT = "nft"
+
+// ListWithContext lists all asset platforms
+// https://api.coingecko.com/api/v3/asset_platforms
+func (s *AssetPlatformsService) ListWithContext(ctx context.Context, options *AssetPlatformsOptions) (AssetPlatforms, *Response, error) {
+	u := url.URL{
+		Path: "/asset_platforms",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var platforms AssetPlatforms
+	resp, err := s.client.Do(req, &platforms)
+	if err != nil {
+		return nil, resp, err
+	}
+	return platforms, resp, nil
+}
+
+// List wraps ListWithContext using the background context
+func (s *AssetPlatformsService) List(options *AssetPlatformsOptions) (AssetPlatforms, *Response, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// PlatformIDWithContext resolves an EVM chain ID to the id of its asset platform, e.g. 1 to "ethereum".
+// It lists the asset platforms, so configure a Cache on the client when resolving often.
+func (s *AssetPlatformsService) PlatformIDWithContext(ctx context.Context, chainID int64) (string, *Response, error) {
+	platforms, resp, err := s.ListWithContext(ctx, nil)
+	if err != nil {
+		return "", resp, err
+	}
+	platformID, ok := platforms.PlatformID(chainID)
+	if !ok {
+		return "", resp, fmt.Errorf("no asset platform found for chain id %d", chainID)
+	}
+	return platformID, resp, nil
+}
+
+// PlatformID wraps PlatformIDWithContext using the background context
+func (s *AssetPlatformsService) PlatformID(chainID int64) (string, *Response, error) {
+	return s.PlatformIDWithContext(context.Background(), chainID)
+}
@@ -0,0 +1,53 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAssetPlatformsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/asset_platforms", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/asset_platforms?filter=nft")
+		fmt.Fprint(w, `[
+			{"id": "ethereum", "chain_identifier": 1, "name": "Ethereum", "shortname": "Ethereum"},
+			{"id": "solana", "chain_identifier": null, "name": "Solana", "shortname": ""}
+		]`)
+	})
+
+	platforms, _, err := testClient.AssetPlatforms.List(&AssetPlatformsOptions{Filter: AssetPlatformsFilterNFT})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(platforms) != 2 || *platforms[0].ChainIdentifier != 1 || platforms[1].ChainIdentifier != nil {
+		t.Errorf("Unexpected platforms: %+v", platforms)
+	}
+	if id, ok := platforms.PlatformID(1); !ok || id != "ethereum" {
+		t.Errorf("PlatformID(1) = %q, %v", id, ok)
+	}
+	if _, ok := platforms.PlatformID(56); ok {
+		t.Error("Expected unknown chain id not to resolve")
+	}
+}
+
+func TestAssetPlatformsService_PlatformID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/asset_platforms", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "binance-smart-chain", "chain_identifier": 56, "name": "BNB Smart Chain", "shortname": "BSC"}]`)
+	})
+
+	id, _, err := testClient.AssetPlatforms.PlatformID(56)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if id != "binance-smart-chain" {
+		t.Errorf("PlatformID: %q, want binance-smart-chain", id)
+	}
+	if _, _, err := testClient.AssetPlatforms.PlatformID(1); err == nil {
+		t.Error("Expected error for an unknown chain id")
+	}
+}
@@ -0,0 +1,296 @@
+package coingecko
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API responses keyed on the request method and resolved URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key for the duration of ttl.
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes the value stored for key, if any.
+	Delete(key string)
+}
+
+// defaultCacheTTL is the time responses are cached for, unless overridden per endpoint.
+// It matches the server-side cache of most CoinGecko endpoints.
+const defaultCacheTTL = 60 * time.Second
+
+// defaultCacheTTLs are the cache TTLs per endpoint, keyed on the endpoint path
+// relative to the base URL. The longest matching path prefix wins.
+var defaultCacheTTLs = map[string]time.Duration{
+	"ping":                       0,
+	"coins/list":                 5 * time.Minute,
+	"coins/categories/list":      5 * time.Minute,
+	"asset_platforms":            5 * time.Minute,
+	"exchanges/list":             5 * time.Minute,
+	"derivatives/exchanges/list": 5 * time.Minute,
+	"nfts/list":                  5 * time.Minute,
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a copy of ctx which makes requests skip the cache of the Client.
+// Responses to such requests are not stored either.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// cacheKey returns the store responses to the request are kept in, the cache key and TTL
+// for the request, and whether the response to it may be stored at all.
+func (c *Client) cacheKey(req *http.Request) (Cache, string, time.Duration, bool) {
+	if req.Method != http.MethodGet {
+		return nil, "", 0, false
+	}
+	if bypass, _ := req.Context().Value(bypassCacheKey{}).(bool); bypass {
+		return nil, "", 0, false
+	}
+	store := c.Cache
+	if store == nil {
+		return nil, "", 0, false
+	}
+	ttl := c.cacheTTL(req)
+	if ttl <= 0 {
+		return nil, "", 0, false
+	}
+	return store, req.Method + " " + req.URL.String(), ttl, true
+}
+
+// cacheTTL returns the TTL of the endpoint of the request
+func (c *Client) cacheTTL(req *http.Request) time.Duration {
+	endpoint := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	endpoint = strings.Trim(endpoint, "/")
+
+	ttl := defaultCacheTTL
+	longest := -1
+	for path, pathTTL := range c.cacheTTLs {
+		if endpoint != path && !strings.HasPrefix(endpoint, path+"/") {
+			continue
+		}
+		if len(path) > longest {
+			longest = len(path)
+			ttl = pathTTL
+		}
+	}
+	return ttl
+}
+
+// storedAtHeader records when a response was stored, to tell fresh entries from ones only kept for revalidation
+const storedAtHeader = "X-Coingecko-Stored-At"
+
+// cachedResponse returns the response stored for key, if any, and the time it was stored
+func cachedResponse(store Cache, key string, req *http.Request) (*http.Response, time.Time, bool) {
+	data, ok := store.Get(key)
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	httpResp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		store.Delete(key)
+		return nil, time.Time{}, false
+	}
+	storedAt, err := time.Parse(time.RFC3339Nano, httpResp.Header.Get(storedAtHeader))
+	if err != nil {
+		store.Delete(key)
+		return nil, time.Time{}, false
+	}
+	httpResp.Header.Del(storedAtHeader)
+	return httpResp, storedAt, true
+}
+
+// storeResponse stores the response for key. The response body is replaced so it can still be read.
+// Responses carrying validators are kept for at least revalidateTTL so they can be revalidated once stale.
+func storeResponse(store Cache, key string, ttl time.Duration, httpResp *http.Response) {
+	if hasValidators(httpResp) && ttl < revalidateTTL {
+		ttl = revalidateTTL
+	}
+
+	httpResp.Header.Set(storedAtHeader, time.Now().Format(time.RFC3339Nano))
+	data, err := httputil.DumpResponse(httpResp, true)
+	httpResp.Header.Del(storedAtHeader)
+	if err != nil {
+		return
+	}
+	store.Set(key, data, ttl)
+}
+
+// setCachedAge adds the time the response spent in the cache to its Age header (RFC 9111, section 4.2.3),
+// so the Response describes its freshness when served rather than when stored.
+func setCachedAge(httpResp *http.Response, storedAt time.Time) {
+	age := time.Duration(headerInt(httpResp.Header, "Age"))*time.Second + time.Since(storedAt)
+	httpResp.Header.Set("Age", strconv.Itoa(int(age/time.Second)))
+}
+
+// MemoryCache is an in-memory Cache which evicts the least recently used
+// entries once it holds more than its capacity.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding up to capacity entries
+func NewMemoryCache(capacity int) (*MemoryCache, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("cache capacity must be positive, got %d", capacity)
+	}
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}, nil
+}
+
+// Get returns the value stored for key, if present and not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		m.remove(element)
+		return nil, false
+	}
+	m.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set stores value for key for the duration of ttl
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expires = expires
+		m.order.MoveToFront(element)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, value: value, expires: expires})
+	for m.order.Len() > m.capacity {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete removes the value stored for key, if any
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		m.remove(element)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones not yet evicted
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// remove removes the element from the cache. m.mu must be held.
+func (m *MemoryCache) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.entries, element.Value.(*memoryCacheEntry).key)
+}
+
+// FileCache is a Cache storing every entry in its own file in a directory,
+// so cached responses survive restarts and can be shared between processes.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache creates a FileCache in dir, creating the directory if needed
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+// Get returns the value stored for key, if present and not expired
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(f.path(key))
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	// Every file starts with the expiry time in Unix nanoseconds
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if time.Now().After(expires) {
+		f.Delete(key)
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set stores value for key for the duration of ttl.
+// The file is written atomically, so concurrent readers never see a partial entry.
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	tmp, err := ioutil.TempFile(f.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	var expires [8]byte
+	binary.BigEndian.PutUint64(expires[:], uint64(time.Now().Add(ttl).UnixNano()))
+	if _, err := tmp.Write(expires[:]); err != nil {
+		tmp.Close()
+		return
+	}
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), f.path(key))
+}
+
+// Delete removes the value stored for key, if any
+func (f *FileCache) Delete(key string) {
+	_ = os.Remove(f.path(key))
+}
+
+// path returns the file an entry is stored in. Keys are hashed as URLs are not valid file names.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
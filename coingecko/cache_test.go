@@ -0,0 +1,165 @@
+package coingecko
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache_Eviction(t *testing.T) {
+	cache, err := NewMemoryCache(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+	cache.Get("a")
+	cache.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	if v, ok := cache.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("Get(a) = %q, %v", v, ok)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len: %d, want 2", cache.Len())
+	}
+
+	cache.Set("d", []byte("4"), -time.Second)
+	if _, ok := cache.Get("d"); ok {
+		t.Error("Expected expired entry to be missing")
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "coingecko-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("GET https://api.coingecko.com/api/v3/ping", []byte("pong"), time.Minute)
+	if v, ok := cache.Get("GET https://api.coingecko.com/api/v3/ping"); !ok || string(v) != "pong" {
+		t.Errorf("Get = %q, %v", v, ok)
+	}
+
+	cache.Set("expired", []byte("x"), -time.Second)
+	if _, ok := cache.Get("expired"); ok {
+		t.Error("Expected expired entry to be missing")
+	}
+
+	cache.Delete("GET https://api.coingecko.com/api/v3/ping")
+	if _, ok := cache.Get("GET https://api.coingecko.com/api/v3/ping"); ok {
+		t.Error("Expected deleted entry to be missing")
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/exchange_rates", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"rates": {"btc": {"name": "Bitcoin", "unit": "BTC", "value": 1, "type": "crypto"}}}`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+
+	for i := 0; i < 2; i++ {
+		rates, resp, err := testClient.ExchangeRate.GetExchangeRates()
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if rates.Rates["btc"].Value != 1 {
+			t.Errorf("Unexpected rates: %+v", rates)
+		}
+		if resp.FromCache != (i == 1) {
+			t.Errorf("Request %d: FromCache %v", i, resp.FromCache)
+		}
+	}
+
+	if _, resp, err := testClient.ExchangeRate.GetExchangeRatesWithContext(BypassCache(context.Background())); err != nil {
+		t.Fatalf("Error given: %s", err)
+	} else if resp.FromCache {
+		t.Error("Expected cache to be bypassed")
+	}
+	if calls != 2 {
+		t.Errorf("Server calls: %d, want 2", calls)
+	}
+}
+
+func TestClient_CacheAge(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchange_rates", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		w.Header().Set("Age", "10")
+		fmt.Fprint(w, `{"rates": {"btc": {"name": "Bitcoin", "unit": "BTC", "value": 1, "type": "crypto"}}}`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+
+	if _, resp, err := testClient.ExchangeRate.GetExchangeRates(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	} else if resp.NextPollIn() != 50*time.Second {
+		t.Errorf("NextPollIn: %v, want 50s", resp.NextPollIn())
+	}
+
+	// Pretend the response was stored 20 seconds ago
+	key := "GET " + testServer.URL + "/exchange_rates"
+	data, ok := cache.Get(key)
+	if !ok {
+		t.Fatal("Expected the response to be cached")
+	}
+	stored, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored.Header.Set(storedAtHeader, time.Now().Add(-20*time.Second).Format(time.RFC3339Nano))
+	data, _ = httputil.DumpResponse(stored, true)
+	cache.Set(key, data, time.Minute)
+
+	_, resp, err := testClient.ExchangeRate.GetExchangeRates()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !resp.FromCache {
+		t.Fatal("Expected a cache hit")
+	}
+	if resp.Age != 30*time.Second || resp.NextPollIn() != 30*time.Second {
+		t.Errorf("Age: %v, NextPollIn: %v, want 30s and 30s", resp.Age, resp.NextPollIn())
+	}
+}
+
+func TestClient_CacheTTL(t *testing.T) {
+	c, err := NewClient(WithCacheTTL("coins/markets", time.Second), WithCacheTTL("/ping/", time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]time.Duration{
+		"coins/markets?vs_currency=usd": time.Second,
+		"coins/list":                    5 * time.Minute,
+		"coins/bitcoin":                 defaultCacheTTL,
+		"ping":                          time.Hour,
+	}
+	for endpoint, want := range tests {
+		req, _ := c.NewRequestWithContext(context.Background(), "GET", endpoint, nil)
+		if got := c.cacheTTL(req); got != want {
+			t.Errorf("cacheTTL(%v) = %v, want %v", endpoint, got, want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package coingecko
+
+import (
+	"context"
+	"net/url"
+)
+
+// CategoriesService handles the coin Categories endpoints for CoinGecko API
+type CategoriesService struct {
+	client *Client
+}
+
+// CategoryListItem is a coin category. CategoryID is the value CoinsQueryOptions.Category takes.
+type CategoryListItem struct {
+	CategoryID string `json:"category_id"`
+	Name       string `json:"name"`
+}
+
+// Category is a coin category with its market data
+type Category struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	MarketCap          float64  `json:"market_cap"`
+	MarketCapChange24H float64  `json:"market_cap_change_24h"`
+	Content            string   `json:"content"`
+	Top3Coins          []string `json:"top_3_coins"`
+	Volume24H          float64  `json:"volume_24h"`
+	UpdatedAt          string   `json:"updated_at"`
+}
+
+type CategoriesQueryOrder struct {
+	MarketCapDesc          string
+	MarketCapAsc           string
+	NameDesc               string
+	NameAsc                string
+	MarketCapChange24HDesc string
+	MarketCapChange24HAsc  string
+}
+
+var CategoriesQueryOrderValues = &CategoriesQueryOrder{
+	MarketCapDesc:          "market_cap_desc",
+	MarketCapAsc:           "market_cap_asc",
+	NameDesc:               "name_desc",
+	NameAsc:                "name_asc",
+	MarketCapChange24HDesc: "market_cap_change_24h_desc",
+	MarketCapChange24HAsc:  "market_cap_change_24h_asc",
+}
+
+// ListWithContext lists all coin categories
+// https://api.coingecko.com/api/v3/coins/categories/list
+func (s *CategoriesService) ListWithContext(ctx context.Context) ([]CategoryListItem, *Response, error) {
+	apiEndpoint := "/coins/categories/list"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []CategoryListItem
+	resp, err := s.client.Do(req, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+	return categories, resp, nil
+}
+
+// List wraps ListWithContext using the background context
+func (s *CategoriesService) List() ([]CategoryListItem, *Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// GetMarketDataWithContext lists all coin categories with their market data, in the given order
+// or by market cap descending if order is empty. See CategoriesQueryOrderValues.
+// https://api.coingecko.com/api/v3/coins/categories
+func (s *CategoriesService) GetMarketDataWithContext(ctx context.Context, order string) ([]Category, *Response, error) {
+	u := url.URL{
+		Path: "/coins/categories",
+	}
+
+	if len(order) > 0 {
+		urlValues := url.Values{}
+		urlValues.Add("order", order)
+		u.RawQuery = urlValues.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []Category
+	resp, err := s.client.Do(req, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+	return categories, resp, nil
+}
+
+// GetMarketData wraps GetMarketDataWithContext using the background context
+func (s *CategoriesService) GetMarketData(order string) ([]Category, *Response, error) {
+	return s.GetMarketDataWithContext(context.Background(), order)
+}
@@ -0,0 +1,61 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCategoriesService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/categories/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"category_id": "decentralized-finance-defi", "name": "Decentralized Finance (DeFi)"}]`)
+	})
+
+	categories, _, err := testClient.Categories.List()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(categories) != 1 || categories[0].CategoryID != "decentralized-finance-defi" {
+		t.Errorf("Unexpected categories: %+v", categories)
+	}
+}
+
+func TestCategoriesService_GetMarketData(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/categories?order=name_asc")
+		fmt.Fprint(w, `[{
+			"id": "layer-1", "name": "Layer 1 (L1)", "market_cap": 2000000000000, "market_cap_change_24h": -1.5,
+			"content": "", "top_3_coins": ["https://example.com/btc.png", "https://example.com/eth.png", "https://example.com/bnb.png"],
+			"volume_24h": 60000000000, "updated_at": "2021-01-01T00:00:00.000Z"
+		}]`)
+	})
+
+	categories, _, err := testClient.Categories.GetMarketData(CategoriesQueryOrderValues.NameAsc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(categories) != 1 || categories[0].MarketCapChange24H != -1.5 || len(categories[0].Top3Coins) != 3 {
+		t.Errorf("Unexpected categories: %+v", categories)
+	}
+}
+
+func TestCoinsService_GetMarkets_Category(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/markets", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("category"); got != "layer-1" {
+			t.Errorf("category: %q, want %q", got, "layer-1")
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	if _, _, err := testClient.Coins.GetMarkets("usd", &CoinsQueryOptions{Category: "layer-1"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
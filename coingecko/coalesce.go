@@ -0,0 +1,156 @@
+package coingecko
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// coalescer deduplicates identical GET requests in flight,
+// so concurrent callers share a single round trip.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// coalescedCall is a request in flight shared by one or more callers
+type coalescedCall struct {
+	done    chan struct{}
+	waiters int
+	cancel  context.CancelFunc
+
+	// Deadline of the caller which started the request, zero if it has none.
+	// The shared request gives up at that deadline, so only callers done by then may join it.
+	deadline time.Time
+
+	// Outcome of the request. The body is read into memory so every caller gets its own copy.
+	resp   *http.Response
+	body   []byte
+	status cacheStatus
+	err    error
+}
+
+// fetchShared fetches the request, sharing the round trip with identical GET requests already in flight.
+// The shared request is only canceled once every caller waiting on it is gone,
+// while each caller stops waiting as soon as its own context is done.
+func (c *Client) fetchShared(req *http.Request) (*http.Response, cacheStatus, error) {
+	if req.Method != http.MethodGet {
+		return c.fetch(req)
+	}
+
+	g := &c.inflight
+	key := req.Method + " " + req.URL.String()
+
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*coalescedCall)
+	}
+	call, ok := g.calls[key]
+	if !ok || !call.covers(req.Context()) {
+		// A request in flight which may give up before this caller would is left to its own callers
+		call = c.startCoalesced(key, req)
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return c.coalescedResponse(call, req)
+	case <-req.Context().Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, cacheMiss, req.Context().Err()
+	}
+}
+
+// startCoalesced starts the shared request for key, bounded by the deadline of the caller's request if any.
+// c.inflight.mu must be held.
+func (c *Client) startCoalesced(key string, req *http.Request) *coalescedCall {
+	call := &coalescedCall{done: make(chan struct{})}
+	var ctx context.Context = detachedContext{parent: req.Context()}
+	if deadline, ok := req.Context().Deadline(); ok {
+		call.deadline = deadline
+		ctx, call.cancel = context.WithDeadline(ctx, deadline)
+	} else {
+		ctx, call.cancel = context.WithCancel(ctx)
+	}
+	c.inflight.calls[key] = call
+	go c.runCoalesced(key, call, req.WithContext(ctx))
+	return call
+}
+
+// runCoalesced fetches the shared request and wakes up its callers
+func (c *Client) runCoalesced(key string, call *coalescedCall, req *http.Request) {
+	defer call.cancel()
+
+	call.resp, call.status, call.err = c.fetch(req)
+	if call.resp != nil {
+		body, err := ioutil.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+		if err != nil && call.err == nil {
+			call.err = err
+		}
+		call.body = body
+		call.resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	c.inflight.mu.Lock()
+	if c.inflight.calls[key] == call {
+		delete(c.inflight.calls, key)
+	}
+	c.inflight.mu.Unlock()
+	close(call.done)
+}
+
+// coalescedResponse returns a copy of the shared response for the caller's request.
+// Errors describing the response are built again from the copy, so callers do not share them.
+func (c *Client) coalescedResponse(call *coalescedCall, req *http.Request) (*http.Response, cacheStatus, error) {
+	if call.resp == nil {
+		return nil, call.status, call.err
+	}
+	resp := *call.resp
+	resp.Header = call.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(call.body))
+	resp.Request = req
+
+	err := call.err
+	if err != nil {
+		if checkErr := CheckResponse(&resp); checkErr != nil {
+			err = c.redactError(checkErr)
+		}
+	}
+	return &resp, call.status, err
+}
+
+// covers reports whether the shared request runs at least as long as the caller's context allows
+func (call *coalescedCall) covers(ctx context.Context) bool {
+	if call.deadline.IsZero() {
+		return true
+	}
+	deadline, ok := ctx.Deadline()
+	return ok && !deadline.After(call.deadline)
+}
+
+// detachedContext carries the values of its parent but is never canceled and has no deadline,
+// so a shared request outlives the caller which started it.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
@@ -0,0 +1,196 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_CoalescesConcurrentRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	var calls int32
+	release := make(chan struct{})
+	testMux.HandleFunc("/coins/bitcoin", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		fmt.Fprint(w, `{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"}`)
+	})
+
+	const callers = 5
+	coins := make([]*Coin, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			coin, _, err := testClient.Coins.GetCoin("bitcoin", nil)
+			if err != nil {
+				t.Errorf("Caller %d: error given: %s", i, err)
+			}
+			coins[i] = coin
+		}(i)
+	}
+
+	// Give every caller the chance to join the request in flight
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Server calls: %d, want 1", got)
+	}
+	for i, coin := range coins {
+		if coin == nil || coin.Name != "Bitcoin" {
+			t.Fatalf("Caller %d: unexpected coin %+v", i, coin)
+		}
+	}
+	if coins[0] == coins[1] {
+		t.Error("Expected every caller to get its own copy of the result")
+	}
+}
+
+func TestClient_CoalescedRequestCallerCancellation(t *testing.T) {
+	setup()
+	defer teardown()
+	release := make(chan struct{})
+	testMux.HandleFunc("/coins/bitcoin", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprint(w, `{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"}`)
+	})
+
+	done := make(chan error)
+	go func() {
+		_, _, err := testClient.Coins.GetCoin("bitcoin", nil)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	if _, _, err := testClient.Coins.GetCoinWithContext(ctx, "bitcoin", nil); err != context.Canceled {
+		t.Errorf("Expected canceled caller to return context.Canceled, got %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Expected the other caller to succeed, got %v", err)
+	}
+}
+
+func TestClient_CoalescedRequestDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+	var calls int32
+	release := make(chan struct{})
+	testMux.HandleFunc("/coins/bitcoin", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		fmt.Fprint(w, `{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"}`)
+	})
+
+	var mu sync.Mutex
+	var deadlines []time.Time
+	testClient.RateLimiter = rateLimiterFunc(func(ctx context.Context) error {
+		deadline, _ := ctx.Deadline()
+		mu.Lock()
+		deadlines = append(deadlines, deadline)
+		mu.Unlock()
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	leaderDeadline, _ := ctx.Deadline()
+	done := make(chan error)
+	go func() {
+		_, _, err := testClient.Coins.GetCoinWithContext(ctx, "bitcoin", nil)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// A caller without a deadline must not share a request which gives up at the leader's deadline
+	go func() {
+		_, _, err := testClient.Coins.GetCoin("bitcoin", nil)
+		done <- err
+	}()
+	if err := <-done; err != context.DeadlineExceeded {
+		t.Errorf("Expected the leader to time out, got %v", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Expected the caller without a deadline to succeed, got %v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Server calls: %d, want 2", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(deadlines) != 2 || !deadlines[0].Equal(leaderDeadline) || !deadlines[1].IsZero() {
+		t.Errorf("Unexpected deadlines seen by the rate limiter: %v, want [%v, zero]", deadlines, leaderDeadline)
+	}
+}
+
+func TestClient_CoalescedRequestErrors(t *testing.T) {
+	setup()
+	defer teardown()
+	release := make(chan struct{})
+	testMux.HandleFunc("/coins/bitcoin", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error": "internal error"}`)
+	})
+
+	const callers = 2
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i] = testClient.Coins.GetCoin("bitcoin", nil)
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	var first, second *ErrorResponse
+	if !errors.As(errs[0], &first) || !errors.As(errs[1], &second) {
+		t.Fatalf("Expected ErrorResponses, got %v and %v", errs[0], errs[1])
+	}
+	if first == second || first.Response == second.Response {
+		t.Fatal("Expected every caller to get its own error")
+	}
+	for i, errorResponse := range []*ErrorResponse{first, second} {
+		body, err := ioutil.ReadAll(errorResponse.Response.Body)
+		if err != nil || !strings.Contains(string(body), "internal error") {
+			t.Errorf("Caller %d: unexpected error response body %q, %v", i, body, err)
+		}
+	}
+	if first.Response.Request == second.Response.Request {
+		t.Error("Expected every error response to carry the caller's own request")
+	}
+}
+
+// rateLimiterFunc adapts a function to the RateLimiter interface
+type rateLimiterFunc func(ctx context.Context) error
+
+func (f rateLimiterFunc) Wait(ctx context.Context) error {
+	return f(ctx)
+}
@@ -9,9 +9,13 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
-const defaultBaseURL = "https://api.coingecko.com/api/v3/"
+const (
+	defaultBaseURL   = "https://api.coingecko.com/api/v3/"
+	defaultUserAgent = "go-coin-gecko"
+)
 
 type Client struct {
 	// HTTP client used to communicate with the API
@@ -20,6 +24,37 @@ type Client struct {
 	// Base URL for API requests
 	BaseURL *url.URL
 
+	// User agent used when communicating with the API
+	UserAgent string
+
+	// Logger used to report retries. Nothing is logged if nil.
+	Logger Logger
+
+	// Rate limiter every request waits on before being sent. Requests are not throttled if nil.
+	RateLimiter RateLimiter
+
+	// Policy for retrying failed requests. Requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+
+	// Cache responses to GET requests are stored in. Responses are not cached if nil.
+	Cache Cache
+
+	// Cache TTLs per endpoint, see WithCacheTTL
+	cacheTTLs map[string]time.Duration
+
+	// Identical GET requests in flight
+	inflight coalescer
+
+	// Middlewares wrapping every attempt to send a request, see Use
+	middlewares []Middleware
+
+	// API key sent with every request and the plan it belongs to
+	apiKey string
+	plan   Plan
+
+	// Timeout of the HTTP client set by WithTimeout
+	timeout time.Duration
+
 	// Services used for talking to the Utilities in the CoinGecko API.
 	Util *UtilService
 
@@ -28,20 +63,61 @@ type Client struct {
 
 	// Services used for talking to the Coins endpoint in the CoinGecko API.
 	Coins *CoinsService
-}
 
-func NewClient(httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{}
-	}
+	// Services used for talking to the Simple endpoint in the CoinGecko API.
+	Simple *SimpleService
+
+	// Services used for talking to the Categories endpoint in the CoinGecko API.
+	Categories *CategoriesService
+
+	// Services used for talking to the Asset Platforms endpoint in the CoinGecko API.
+	AssetPlatforms *AssetPlatformsService
+
+	// Services used for talking to the Exchanges endpoint in the CoinGecko API.
+	Exchanges *ExchangesService
+
+	// Services used for talking to the Derivatives endpoint in the CoinGecko API.
+	Derivatives *DerivativesService
 
+	// Services used for talking to the NFTs endpoint in the CoinGecko API.
+	NFTs *NFTService
+
+	// Services used for talking to the Search endpoint in the CoinGecko API.
+	Search *SearchService
+}
+
+// NewClient returns a new CoinGecko API client configured by the given options.
+// Without options, the client talks to the public API using a default HTTP client.
+func NewClient(opts ...Option) (*Client, error) {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL}
+	c := &Client{client: &http.Client{}, BaseURL: baseURL, UserAgent: defaultUserAgent}
+	c.cacheTTLs = make(map[string]time.Duration, len(defaultCacheTTLs))
+	for endpoint, ttl := range defaultCacheTTLs {
+		c.cacheTTLs[endpoint] = ttl
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if c.timeout > 0 {
+		httpClient := *c.client
+		httpClient.Timeout = c.timeout
+		c.client = &httpClient
+	}
+
 	c.Util = &UtilService{client: c}
 	c.ExchangeRate = &ExchangeRateService{client: c}
 	c.Coins = &CoinsService{client: c}
-	return c
+	c.Simple = &SimpleService{client: c}
+	c.Categories = &CategoriesService{client: c}
+	c.AssetPlatforms = &AssetPlatformsService{client: c}
+	c.Exchanges = &ExchangesService{client: c}
+	c.Derivatives = &DerivativesService{client: c}
+	c.NFTs = &NFTService{client: c}
+	c.Search = &SearchService{client: c}
+	return c, nil
 }
 
 // NewRequestWithContext creates an API request
@@ -54,6 +130,7 @@ func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr strin
 	}
 	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
 	rel.Path = strings.TrimLeft(rel.Path, "/")
+	rel.RawPath = strings.TrimLeft(rel.RawPath, "/")
 
 	u := c.BaseURL.ResolveReference(rel)
 
@@ -72,41 +149,179 @@ func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr strin
 	}
 
 	req.Header.Set("Context-Type", "application/json")
+	if len(c.UserAgent) > 0 {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if len(c.apiKey) > 0 {
+		req.Header.Set(c.plan.apiKeyHeader(), c.apiKey)
+	}
 	return req, nil
 }
 
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occured
-func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	httpResp, err := c.client.Do(req)
-	if err != nil {
+// Responses to GET requests are served from and stored in the Cache of the Client, if any,
+// and revalidated with conditional requests once stale.
+// Identical concurrent GET requests share a single round trip, each caller decoding its own copy of the result.
+// Responses decoded as a stream skip both, as caching and sharing them requires reading the whole body first.
+// Failed requests are retried according to the RetryPolicy of the Client, if any.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	var httpResp *http.Response
+	var status cacheStatus
+	var err error
+	if _, ok := v.(streamDecoder); ok {
+		httpResp, err = c.send(req)
+	} else {
+		httpResp, status, err = c.fetchShared(req)
+	}
+	if httpResp == nil {
 		return nil, err
 	}
 
-	err = CheckResponse(httpResp)
+	resp := newResponse(httpResp)
+	resp.FromCache = status != cacheMiss
+	resp.NotModified = status == cacheRevalidated
 	if err != nil {
 		// Even though there was an error, we will still return the response
 		// in case the caller wants to inspect it further
-		return httpResp, err
+		return resp, err
 	}
 
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		decoder := json.NewDecoder(httpResp.Body)
+		if stream, ok := v.(streamDecoder); ok {
+			err = stream.decodeStream(decoder)
+		} else {
+			err = decoder.Decode(v)
+		}
 	}
 
-	return httpResp, err
+	return resp, err
+}
+
+// streamDecoder is implemented by values decoding large responses element by element
+type streamDecoder interface {
+	decodeStream(decoder *json.Decoder) error
 }
 
-// CheckResponse checks the API response for errors, and returns them if present.
-// A response is considered an error if it has a status code outside the 200 range.
-// The caller is responsible to analyze the response body.
-func CheckResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
-		return nil
+// fetch returns the cached response to the request if there is a fresh one,
+// or sends the request and caches the response.
+// A stale cached response is revalidated with a conditional request.
+func (c *Client) fetch(req *http.Request) (*http.Response, cacheStatus, error) {
+	store, key, ttl, cacheable := c.cacheKey(req)
+	if !cacheable {
+		httpResp, err := c.send(req)
+		return httpResp, cacheMiss, c.unexpectedNotModified(httpResp, err)
+	}
+
+	cached, storedAt, found := cachedResponse(store, key, req)
+	if found {
+		if time.Since(storedAt) < ttl {
+			setCachedAge(cached, storedAt)
+			return cached, cacheHit, nil
+		}
+		setConditionalHeaders(req, cached)
 	}
 
-	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
+	httpResp, err := c.send(req)
+	if found && httpResp != nil && httpResp.StatusCode == http.StatusNotModified {
+		httpResp.Body.Close()
+		updateCachedHeaders(cached, httpResp)
+		storeResponse(store, key, ttl, cached)
+		return cached, cacheRevalidated, nil
+	}
+	if err = c.unexpectedNotModified(httpResp, err); err == nil {
+		storeResponse(store, key, ttl, httpResp)
+	}
+	return httpResp, cacheMiss, err
+}
+
+// unexpectedNotModified turns a 304 Not Modified response into an error when there is no cached
+// response to serve instead, i.e. for requests made conditional by the caller.
+func (c *Client) unexpectedNotModified(httpResp *http.Response, err error) error {
+	if err == nil && httpResp.StatusCode == http.StatusNotModified {
+		return c.redactError(CheckResponse(httpResp))
+	}
 	return err
 }
+
+// send sends the request, retrying it while the RetryPolicy allows.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		httpResp, err := c.sendOnce(req)
+
+		policy := c.RetryPolicy
+		if policy == nil {
+			return httpResp, err
+		}
+
+		retry := policy.retryable(req, attempt, err)
+		var delay time.Duration
+		if retry {
+			delay = policy.delay(attempt, httpResp)
+		}
+		if policy.OnAttempt != nil {
+			policy.OnAttempt(RetryAttempt{
+				Attempt:  attempt,
+				Request:  req,
+				Response: httpResp,
+				Err:      err,
+				Retry:    retry,
+				Delay:    delay,
+			})
+		}
+		if !retry {
+			return httpResp, err
+		}
+		c.logf("%v %v failed: %v; retrying in %v (attempt %d of %d)", req.Method, redactURL(req.URL), err, delay, attempt, policy.MaxAttempts)
+
+		if httpResp != nil {
+			httpResp.Body.Close()
+		}
+		if sleepErr := sleepContext(req.Context(), delay); sleepErr != nil {
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			// The next attempt would land past the deadline, so report the last failure
+			return httpResp, err
+		}
+	}
+}
+
+// sendOnce waits on the rate limiter and sends the request once through the middleware chain.
+func (c *Client) sendOnce(req *http.Request) (*http.Response, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	httpResp, err := c.roundTrip(req)
+	if err != nil {
+		return nil, c.redactError(err)
+	}
+
+	// Not Modified answers a conditional request successfully, the cached response is served instead
+	if httpResp.StatusCode == http.StatusNotModified && isConditional(req) {
+		return httpResp, nil
+	}
+	return httpResp, c.redactError(CheckResponse(httpResp))
+}
+
+// logf logs a message to the Logger of the client, if any, with the API key redacted
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.Logger == nil {
+		return
+	}
+	c.Logger.Printf("%s", c.redact(fmt.Sprintf(format, v...)))
+}
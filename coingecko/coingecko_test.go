@@ -3,7 +3,6 @@ package coingecko
 import (
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"strings"
 	"testing"
 )
@@ -24,9 +23,7 @@ var (
 func setup() {
 	testMux = http.NewServeMux()
 	testServer = httptest.NewServer(testMux)
-	testClient = NewClient(nil)
-	url, _ := url.Parse(testServer.URL)
-	testClient.BaseURL = url
+	testClient, _ = NewClient(WithBaseURL(testServer.URL))
 }
 
 // teardown closes the test HTTP server.
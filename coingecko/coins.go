@@ -3,7 +3,6 @@ package coingecko
 import (
 	"context"
 	"errors"
-	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -160,6 +159,7 @@ type Ticker struct {
 		Name                string `json:"name"`
 		Identifier          string `json:"identifier"`
 		HasTradingIncentive bool   `json:"has_trading_incentive"`
+		Logo                string `json:"logo,omitempty"`
 	} `json:"market"`
 	Last                   float64            `json:"last"`
 	Volume                 float64            `json:"volume"`
@@ -175,6 +175,8 @@ type Ticker struct {
 	TradeURL               string             `json:"trade_url"`
 	CoinID                 string             `json:"coin_id"`
 	TargetCoinID           string             `json:"target_coin_id"`
+	CostToMoveUpUSD        *float64           `json:"cost_to_move_up_usd,omitempty"`
+	CostToMoveDownUSD      *float64           `json:"cost_to_move_down_usd,omitempty"`
 }
 
 type Links struct {
@@ -309,7 +311,7 @@ var CoinsPriceChangePercentageValues = &CoinsPriceChangePercentage{
 
 // GetMarkets gets List all supported coins price, market cap, volume, and market related data
 // https://api.coingecko.com/api/v3/coins/markets
-func (s *CoinsService) GetMarketsWithContext(ctx context.Context, vsCurrency string, options *CoinsQueryOptions) (*CoinsMarketData, *http.Response, error) {
+func (s *CoinsService) GetMarketsWithContext(ctx context.Context, vsCurrency string, options *CoinsQueryOptions) (*CoinsMarketData, *Response, error) {
 	if len(vsCurrency) == 0 {
 		return nil, nil, errors.New("target currency is required")
 	}
@@ -336,6 +338,10 @@ func (s *CoinsService) GetMarketsWithContext(ctx context.Context, vsCurrency str
 	priceChangePercentageString := strings.Join(priceChangePercentage, ",")
 
 	if options != nil {
+		if len(options.Category) > 0 {
+			urlValues.Add("category", options.Category)
+		}
+
 		if len(options.CoinIDs) > 0 {
 			coinIDStr = strings.Join(options.CoinIDs, ",")
 		}
@@ -382,13 +388,13 @@ func (s *CoinsService) GetMarketsWithContext(ctx context.Context, vsCurrency str
 }
 
 // GetExchangeRates wraps GetMarketsWithContext using the background context
-func (s *CoinsService) GetMarkets(currency string, options *CoinsQueryOptions) (*CoinsMarketData, *http.Response, error) {
+func (s *CoinsService) GetMarkets(currency string, options *CoinsQueryOptions) (*CoinsMarketData, *Response, error) {
 	return s.GetMarketsWithContext(context.Background(), currency, options)
 }
 
 // Get current data (name, price, market, … including exchange tickers) for a coin.
 // https://api.coingecko.com/api/v3/coins/{id}
-func (s *CoinsService) GetCoinWithContext(ctx context.Context, coinID string, options *CoinsQueryOptions) (*Coin, *http.Response, error) {
+func (s *CoinsService) GetCoinWithContext(ctx context.Context, coinID string, options *CoinsQueryOptions) (*Coin, *Response, error) {
 	if len(coinID) == 0 {
 		return nil, nil, errors.New("target coin id is required")
 	}
@@ -419,6 +425,6 @@ func (s *CoinsService) GetCoinWithContext(ctx context.Context, coinID string, op
 }
 
 // GetCoin wraps GetCoinWithContext using the background context
-func (s *CoinsService) GetCoin(ID string, options *CoinsQueryOptions) (*Coin, *http.Response, error) {
+func (s *CoinsService) GetCoin(ID string, options *CoinsQueryOptions) (*Coin, *Response, error) {
 	return s.GetCoinWithContext(context.Background(), ID, options)
 }
@@ -0,0 +1,77 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// contractPath returns the path of the contract endpoints of a token
+func contractPath(platformID string, contractAddress string) (string, error) {
+	if len(platformID) == 0 {
+		return "", errors.New("asset platform id is required")
+	}
+	if len(contractAddress) == 0 {
+		return "", errors.New("contract address is required")
+	}
+	return "/coins/" + url.PathEscape(platformID) + "/contract/" + url.PathEscape(contractAddress), nil
+}
+
+// GetCoinByContractWithContext gets current data of a token by its contract address on an asset platform
+// https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}
+func (s *CoinsService) GetCoinByContractWithContext(ctx context.Context, platformID string, contractAddress string) (*Coin, *Response, error) {
+	path, err := contractPath(platformID, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coinInfo := new(Coin)
+	resp, err := s.client.Do(req, coinInfo)
+	if err != nil {
+		return nil, resp, err
+	}
+	return coinInfo, resp, nil
+}
+
+// GetCoinByContract wraps GetCoinByContractWithContext using the background context
+func (s *CoinsService) GetCoinByContract(platformID string, contractAddress string) (*Coin, *Response, error) {
+	return s.GetCoinByContractWithContext(context.Background(), platformID, contractAddress)
+}
+
+// GetContractMarketChartWithContext gets the historical market data of a token by its contract address for the last days,
+// with days a number of days or "max". See Granularity for the granularity of the points.
+// https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}/market_chart
+func (s *CoinsService) GetContractMarketChartWithContext(ctx context.Context, platformID string, contractAddress string, vsCurrency string, days string, interval Granularity) (*MarketChart, *Response, error) {
+	path, err := contractPath(platformID, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.client.getMarketChart(ctx, path+"/market_chart", vsCurrency, days, interval)
+}
+
+// GetContractMarketChart wraps GetContractMarketChartWithContext using the background context
+func (s *CoinsService) GetContractMarketChart(platformID string, contractAddress string, vsCurrency string, days string, interval Granularity) (*MarketChart, *Response, error) {
+	return s.GetContractMarketChartWithContext(context.Background(), platformID, contractAddress, vsCurrency, days, interval)
+}
+
+// GetContractMarketChartRangeWithContext gets the historical market data of a token by its contract address
+// between two points in time. See Granularity for the granularity of the points.
+// https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}/market_chart/range
+func (s *CoinsService) GetContractMarketChartRangeWithContext(ctx context.Context, platformID string, contractAddress string, vsCurrency string, from time.Time, to time.Time) (*MarketChart, *Response, error) {
+	path, err := contractPath(platformID, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.client.getMarketChartRange(ctx, path+"/market_chart/range", vsCurrency, from, to)
+}
+
+// GetContractMarketChartRange wraps GetContractMarketChartRangeWithContext using the background context
+func (s *CoinsService) GetContractMarketChartRange(platformID string, contractAddress string, vsCurrency string, from time.Time, to time.Time) (*MarketChart, *Response, error) {
+	return s.GetContractMarketChartRangeWithContext(context.Background(), platformID, contractAddress, vsCurrency, from, to)
+}
@@ -0,0 +1,68 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testContractAddress = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+
+func TestCoinsService_GetCoinByContract(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/ethereum/contract/"+testContractAddress, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "usd-coin", "symbol": "usdc", "asset_platform_id": "ethereum", "contract_address": "`+testContractAddress+`"}`)
+	})
+
+	coin, _, err := testClient.Coins.GetCoinByContract("ethereum", testContractAddress)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if coin.ID != "usd-coin" || coin.ContractAddress != testContractAddress {
+		t.Errorf("Unexpected coin: %+v", coin)
+	}
+
+	if _, _, err := testClient.Coins.GetCoinByContract("ethereum", ""); err == nil {
+		t.Error("Expected error for a missing contract address")
+	}
+}
+
+func TestCoinsService_GetCoinByContract_Escaping(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/coins/ethereum/contract/0xabc%2Fdef%3Fx"; got != want {
+			t.Errorf("Request path: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id": "usd-coin"}`)
+	})
+
+	if _, _, err := testClient.Coins.GetCoinByContract("ethereum", "0xabc/def?x"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestCoinsService_GetContractMarketChartRange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/ethereum/contract/"+testContractAddress+"/market_chart/range", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/ethereum/contract/"+testContractAddress+"/market_chart/range?from=1609459200&to=1609545600&vs_currency=usd")
+		fmt.Fprint(w, `{"prices": [[1609459200000, 1.001]], "market_caps": [[1609459200000, 4000000000]], "total_volumes": [[1609459200000, 500000000]]}`)
+	})
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	chart, _, err := testClient.Coins.GetContractMarketChartRange("ethereum", testContractAddress, "usd", from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(chart.Prices) != 1 || !chart.Prices[0].Time.Equal(from) || chart.Prices[0].Value != 1.001 {
+		t.Errorf("Unexpected prices: %+v", chart.Prices)
+	}
+	if chart.Granularity != Granularity5Minutely {
+		t.Errorf("Granularity: %v, want 5m", chart.Granularity)
+	}
+}
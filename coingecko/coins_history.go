@@ -0,0 +1,70 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// historyDateLayout is the dd-mm-yyyy date format of the coin history endpoint
+const historyDateLayout = "02-01-2006"
+
+// CoinHistory is a snapshot of a coin at a past date
+type CoinHistory struct {
+	ID                  string              `json:"id"`
+	Symbol              string              `json:"symbol"`
+	Name                string              `json:"name"`
+	Localization        Localization        `json:"localization"`
+	Image               *Image              `json:"image"`
+	MarketData          *HistoryMarketData  `json:"market_data"`
+	CommunityData       *CommunityData      `json:"community_data"`
+	DeveloperData       *DeveloperData      `json:"developer_data"`
+	PublicInterestStats *PublicInterestStat `json:"public_interest_stats"`
+}
+
+// HistoryMarketData is the market data of a coin at a past date, keyed on currency
+type HistoryMarketData struct {
+	CurrentPrice CurrencyPrice `json:"current_price"`
+	MarketCap    CurrencyPrice `json:"market_cap"`
+	TotalVolume  CurrencyPrice `json:"total_volume"`
+}
+
+// GetHistoryWithContext gets the price, market cap, volume, community and developer data of a coin
+// at 00:00 UTC of the given date. The date is converted to UTC before its day is taken.
+// https://api.coingecko.com/api/v3/coins/{id}/history
+func (s *CoinsService) GetHistoryWithContext(ctx context.Context, coinID string, date time.Time, localization bool) (*CoinHistory, *Response, error) {
+	if len(coinID) == 0 {
+		return nil, nil, errors.New("target coin id is required")
+	}
+	if date.IsZero() {
+		return nil, nil, errors.New("date is required")
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("date", date.UTC().Format(historyDateLayout))
+	urlValues.Add("localization", strconv.FormatBool(localization))
+
+	u := url.URL{
+		Path:     "/coins/" + coinID + "/history",
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	history := new(CoinHistory)
+	resp, err := s.client.Do(req, history)
+	if err != nil {
+		return nil, resp, err
+	}
+	return history, resp, nil
+}
+
+// GetHistory wraps GetHistoryWithContext using the background context
+func (s *CoinsService) GetHistory(coinID string, date time.Time, localization bool) (*CoinHistory, *Response, error) {
+	return s.GetHistoryWithContext(context.Background(), coinID, date, localization)
+}
@@ -0,0 +1,39 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCoinsService_GetHistory(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/history", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/bitcoin/history?date=30-12-2020&localization=false")
+		fmt.Fprint(w, `{
+			"id": "bitcoin", "symbol": "btc", "name": "Bitcoin",
+			"market_data": {"current_price": {"usd": 28856.59}, "market_cap": {"usd": 536000000000}, "total_volume": {"usd": 49000000000}},
+			"community_data": {"twitter_followers": 100},
+			"developer_data": {"forks": 30000}
+		}`)
+	})
+
+	// 2020-12-31 01:00 in UTC+2 is still 2020-12-30 in UTC
+	date := time.Date(2020, 12, 31, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	history, _, err := testClient.Coins.GetHistory("bitcoin", date, false)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if history.MarketData.CurrentPrice["usd"] != 28856.59 {
+		t.Errorf("Unexpected market data: %+v", history.MarketData)
+	}
+	if history.CommunityData == nil || *history.CommunityData.TwitterFollowers != 100 {
+		t.Errorf("Unexpected community data: %+v", history.CommunityData)
+	}
+	if history.DeveloperData == nil || *history.DeveloperData.Forks != 30000 {
+		t.Errorf("Unexpected developer data: %+v", history.DeveloperData)
+	}
+}
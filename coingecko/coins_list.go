@@ -0,0 +1,116 @@
+package coingecko
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// CoinListItem is a coin in the coins list
+type CoinListItem struct {
+	ID     string `json:"id"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+
+	// Contract addresses keyed on asset platform id, only set with IncludePlatform
+	Platforms map[string]string `json:"platforms,omitempty"`
+}
+
+// CoinList is the list of all supported coins
+type CoinList []CoinListItem
+
+// CoinsListOptions are the optional query parameters of the coins list endpoint
+type CoinsListOptions struct {
+	IncludePlatform bool `url:"include_platform,omitempty"`
+}
+
+// coinListFunc streams the coins of the list to a callback
+type coinListFunc func(CoinListItem) error
+
+func (fn coinListFunc) decodeStream(decoder *json.Decoder) error {
+	return decodeArray(decoder, func(decoder *json.Decoder) error {
+		var item CoinListItem
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+		return fn(item)
+	})
+}
+
+// decodeArray decodes a JSON array, calling decodeElement for every element.
+func decodeArray(decoder *json.Decoder, decodeElement func(*json.Decoder) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", token)
+	}
+	for decoder.More() {
+		if err := decodeElement(decoder); err != nil {
+			return err
+		}
+	}
+	_, err = decoder.Token()
+	return err
+}
+
+// ListWithContext lists all supported coins with id, name and symbol.
+// As the payload holds well over 10k coins, they are decoded one at a time as the response is received,
+// so it is neither cached nor shared with identical requests.
+// https://api.coingecko.com/api/v3/coins/list
+func (s *CoinsService) ListWithContext(ctx context.Context, options *CoinsListOptions) (CoinList, *Response, error) {
+	var coins CoinList
+	resp, err := s.list(ctx, options, coinListFunc(func(coin CoinListItem) error {
+		coins = append(coins, coin)
+		return nil
+	}))
+	if err != nil {
+		return nil, resp, err
+	}
+	return coins, resp, nil
+}
+
+// List wraps ListWithContext using the background context
+func (s *CoinsService) List(options *CoinsListOptions) (CoinList, *Response, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListFuncWithContext lists all supported coins like ListWithContext, but passes every coin to fn
+// as soon as it is decoded instead of collecting them. Listing stops at the first error returned by fn.
+func (s *CoinsService) ListFuncWithContext(ctx context.Context, options *CoinsListOptions, fn func(CoinListItem) error) (*Response, error) {
+	if fn == nil {
+		return nil, errors.New("coin list callback is required")
+	}
+	return s.list(ctx, options, coinListFunc(fn))
+}
+
+// ListFunc wraps ListFuncWithContext using the background context
+func (s *CoinsService) ListFunc(options *CoinsListOptions, fn func(CoinListItem) error) (*Response, error) {
+	return s.ListFuncWithContext(context.Background(), options, fn)
+}
+
+func (s *CoinsService) list(ctx context.Context, options *CoinsListOptions, v streamDecoder) (*Response, error) {
+	u := url.URL{
+		Path: "/coins/list",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, v)
+}
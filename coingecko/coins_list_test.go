@@ -0,0 +1,124 @@
+package coingecko
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCoinsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/list?include_platform=true")
+		fmt.Fprint(w, `[
+			{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin", "platforms": {}},
+			{"id": "usd-coin", "symbol": "usdc", "name": "USDC", "platforms": {"ethereum": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}}
+		]`)
+	})
+
+	coins, _, err := testClient.Coins.List(&CoinsListOptions{IncludePlatform: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(coins) != 2 {
+		t.Fatalf("Coins: %d, want 2", len(coins))
+	}
+	if coins[1].ID != "usd-coin" || coins[1].Platforms["ethereum"] != "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48" {
+		t.Errorf("Unexpected coin: %+v", coins[1])
+	}
+
+	var symbols []string
+	stop := errors.New("stop")
+	_, err = testClient.Coins.ListFunc(&CoinsListOptions{IncludePlatform: true}, func(coin CoinListItem) error {
+		symbols = append(symbols, coin.Symbol)
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Expected callback error to be returned, got %v", err)
+	}
+	if len(symbols) != 1 || symbols[0] != "btc" {
+		t.Errorf("Unexpected symbols: %v", symbols)
+	}
+}
+
+func TestCoinsService_List_NotAnArray(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/list", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "bitcoin"}`)
+	})
+
+	if _, _, err := testClient.Coins.List(nil); err == nil {
+		t.Error("Expected error for a payload which is not an array")
+	}
+}
+
+func TestCoinsService_ListFunc_Streams(t *testing.T) {
+	setup()
+	defer teardown()
+	firstDecoded := make(chan struct{})
+	testMux.HandleFunc("/coins/list", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"},`)
+		w.(http.Flusher).Flush()
+
+		// The rest of the list is only sent once the first coin reached the callback
+		select {
+		case <-firstDecoded:
+		case <-time.After(time.Second):
+			t.Error("Expected the first coin to be decoded before the whole body was received")
+		}
+		fmt.Fprint(w, `{"id": "ethereum", "symbol": "eth", "name": "Ethereum"}]`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+
+	var ids []string
+	_, err := testClient.Coins.ListFunc(nil, func(coin CoinListItem) error {
+		if len(ids) == 0 {
+			close(firstDecoded)
+		}
+		ids = append(ids, coin.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(ids) != 2 || ids[1] != "ethereum" {
+		t.Errorf("Unexpected ids: %v", ids)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Expected the streamed response not to be cached, cache holds %d entries", cache.Len())
+	}
+}
+
+func TestCoinsService_List_Streams(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/coins/list", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `[{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"}]`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+
+	// Streamed responses are decoded straight off the wire, without going through the cache
+	for i := 0; i < 2; i++ {
+		coins, resp, err := testClient.Coins.List(nil)
+		if err != nil {
+			t.Fatalf("Request %d: error given: %s", i, err)
+		}
+		if len(coins) != 1 || resp.FromCache {
+			t.Errorf("Request %d: coins %+v, FromCache %v", i, coins, resp.FromCache)
+		}
+	}
+	if cache.Len() != 0 || calls != 2 {
+		t.Errorf("Cache entries: %d, server calls: %d, want 0 and 2", cache.Len(), calls)
+	}
+}
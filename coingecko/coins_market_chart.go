@@ -0,0 +1,221 @@
+package coingecko
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Granularity is the interval between the points of a market chart
+type Granularity string
+
+// Market chart granularities.
+// Unless an interval is requested, CoinGecko picks the granularity from the time span:
+// 5-minutely for spans up to 1 day, hourly for spans up to 90 days, daily beyond.
+// Requesting the 5-minutely or hourly interval requires the Pro plan.
+const (
+	GranularityAuto      Granularity = ""
+	Granularity5Minutely Granularity = "5m"
+	GranularityHourly    Granularity = "hourly"
+	GranularityDaily     Granularity = "daily"
+)
+
+// Point is a value at a point in time of a time series
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// UnmarshalJSON decodes a [timestamp in milliseconds, value] pair.
+// The timestamp must be a number, while the value may be a number or a numeric string;
+// a null value decodes to zero.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	var timestamp *float64
+	if len(pair) != 2 || json.Unmarshal(pair[0], &timestamp) != nil || timestamp == nil {
+		return fmt.Errorf("expected [timestamp, value] pair, got %s", data)
+	}
+	value, err := decodeNumber(pair[1])
+	if err != nil {
+		return err
+	}
+	p.Time = millisecondsToTime(*timestamp)
+	p.Value = value
+	return nil
+}
+
+// decodeNumber decodes a JSON number, a numeric JSON string or null, which decodes to zero.
+func decodeNumber(data json.RawMessage) (float64, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return v, nil
+	case string:
+		if len(v) == 0 {
+			return 0, nil
+		}
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid numeric string %q", v)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("expected number, got %s", data)
+}
+
+// millisecondsToTime converts a Unix timestamp in milliseconds to a UTC time
+func millisecondsToTime(ms float64) time.Time {
+	return time.Unix(0, int64(ms)*int64(time.Millisecond)).UTC()
+}
+
+// MarketChart is the price, market cap and volume history of a coin
+type MarketChart struct {
+	Prices       []Point `json:"prices"`
+	MarketCaps   []Point `json:"market_caps"`
+	TotalVolumes []Point `json:"total_volumes"`
+
+	// Granularity of the points, as requested or derived from the time span
+	Granularity Granularity `json:"-"`
+}
+
+// GetMarketChartWithContext gets the historical market data of a coin for the last days,
+// with days a number of days or "max". See Granularity for the granularity of the points.
+// https://api.coingecko.com/api/v3/coins/{id}/market_chart
+func (s *CoinsService) GetMarketChartWithContext(ctx context.Context, coinID string, vsCurrency string, days string, interval Granularity) (*MarketChart, *Response, error) {
+	if len(coinID) == 0 {
+		return nil, nil, errors.New("target coin id is required")
+	}
+	return s.client.getMarketChart(ctx, "/coins/"+coinID+"/market_chart", vsCurrency, days, interval)
+}
+
+// GetMarketChart wraps GetMarketChartWithContext using the background context
+func (s *CoinsService) GetMarketChart(coinID string, vsCurrency string, days string, interval Granularity) (*MarketChart, *Response, error) {
+	return s.GetMarketChartWithContext(context.Background(), coinID, vsCurrency, days, interval)
+}
+
+// GetMarketChartRangeWithContext gets the historical market data of a coin between two points in time.
+// See Granularity for the granularity of the points.
+// https://api.coingecko.com/api/v3/coins/{id}/market_chart/range
+func (s *CoinsService) GetMarketChartRangeWithContext(ctx context.Context, coinID string, vsCurrency string, from time.Time, to time.Time) (*MarketChart, *Response, error) {
+	if len(coinID) == 0 {
+		return nil, nil, errors.New("target coin id is required")
+	}
+	return s.client.getMarketChartRange(ctx, "/coins/"+coinID+"/market_chart/range", vsCurrency, from, to)
+}
+
+// GetMarketChartRange wraps GetMarketChartRangeWithContext using the background context
+func (s *CoinsService) GetMarketChartRange(coinID string, vsCurrency string, from time.Time, to time.Time) (*MarketChart, *Response, error) {
+	return s.GetMarketChartRangeWithContext(context.Background(), coinID, vsCurrency, from, to)
+}
+
+// getMarketChart gets a market chart for the last days from the given endpoint
+func (c *Client) getMarketChart(ctx context.Context, path string, vsCurrency string, days string, interval Granularity) (*MarketChart, *Response, error) {
+	if len(vsCurrency) == 0 {
+		return nil, nil, errors.New("target currency is required")
+	}
+	granularity, err := marketChartGranularity(days, interval)
+	if err != nil {
+		return nil, nil, err
+	}
+	if interval == Granularity5Minutely || interval == GranularityHourly {
+		if err := c.requirePlan(path+"?interval="+string(interval), ProPlan); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("vs_currency", vsCurrency)
+	urlValues.Add("days", days)
+	if interval != GranularityAuto {
+		urlValues.Add("interval", string(interval))
+	}
+
+	return c.fetchMarketChart(ctx, path, urlValues, granularity)
+}
+
+// getMarketChartRange gets a market chart between two points in time from the given endpoint
+func (c *Client) getMarketChartRange(ctx context.Context, path string, vsCurrency string, from time.Time, to time.Time) (*MarketChart, *Response, error) {
+	if len(vsCurrency) == 0 {
+		return nil, nil, errors.New("target currency is required")
+	}
+	if !from.Before(to) {
+		return nil, nil, errors.New("from must be before to")
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("vs_currency", vsCurrency)
+	urlValues.Add("from", strconv.FormatInt(from.Unix(), 10))
+	urlValues.Add("to", strconv.FormatInt(to.Unix(), 10))
+
+	return c.fetchMarketChart(ctx, path, urlValues, spanGranularity(to.Sub(from)))
+}
+
+func (c *Client) fetchMarketChart(ctx context.Context, path string, urlValues url.Values, granularity Granularity) (*MarketChart, *Response, error) {
+	u := url.URL{
+		Path:     path,
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	marketChart := new(MarketChart)
+	resp, err := c.Do(req, marketChart)
+	if err != nil {
+		return nil, resp, err
+	}
+	marketChart.Granularity = granularity
+	return marketChart, resp, nil
+}
+
+// marketChartGranularity validates the days and interval of a market chart request
+// and returns the granularity of the points CoinGecko will return.
+func marketChartGranularity(days string, interval Granularity) (Granularity, error) {
+	switch interval {
+	case GranularityAuto, Granularity5Minutely, GranularityHourly, GranularityDaily:
+	default:
+		return "", fmt.Errorf("invalid market chart interval %q", interval)
+	}
+
+	if days == "max" {
+		if interval != GranularityAuto && interval != GranularityDaily {
+			return "", fmt.Errorf("interval %q is not available for days=max", interval)
+		}
+		return GranularityDaily, nil
+	}
+	n, err := strconv.Atoi(days)
+	if err != nil || n < 1 {
+		return "", fmt.Errorf("days must be a positive number of days or \"max\", got %q", days)
+	}
+
+	if interval != GranularityAuto {
+		return interval, nil
+	}
+	return spanGranularity(time.Duration(n) * 24 * time.Hour), nil
+}
+
+// spanGranularity returns the granularity CoinGecko picks for a time span
+func spanGranularity(span time.Duration) Granularity {
+	switch {
+	case span <= 24*time.Hour:
+		return Granularity5Minutely
+	case span <= 90*24*time.Hour:
+		return GranularityHourly
+	default:
+		return GranularityDaily
+	}
+}
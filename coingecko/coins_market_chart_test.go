@@ -0,0 +1,125 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCoinsService_GetMarketChart(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/market_chart", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/bitcoin/market_chart?days=30&vs_currency=usd")
+		fmt.Fprint(w, `{
+			"prices": [[1609459200000, 28994.01], [1609462800000, 29100.5]],
+			"market_caps": [[1609459200000, 539000000000]],
+			"total_volumes": [[1609459200000, null]]
+		}`)
+	})
+
+	chart, _, err := testClient.Coins.GetMarketChart("bitcoin", "usd", "30", GranularityAuto)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(chart.Prices) != 2 || chart.Prices[1].Value != 29100.5 {
+		t.Errorf("Unexpected prices: %+v", chart.Prices)
+	}
+	if !chart.Prices[0].Time.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected time: %v", chart.Prices[0].Time)
+	}
+	if chart.TotalVolumes[0].Value != 0 {
+		t.Errorf("Expected null volume to decode to zero, got %v", chart.TotalVolumes[0].Value)
+	}
+	if chart.Granularity != GranularityHourly {
+		t.Errorf("Granularity: %v, want hourly", chart.Granularity)
+	}
+}
+
+func TestCoinsService_GetMarketChartRange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/market_chart/range", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/bitcoin/market_chart/range?from=1609459200&to=1617321600&vs_currency=eur")
+		fmt.Fprint(w, `{"prices": [[1609459200000, 23700]], "market_caps": [], "total_volumes": []}`)
+	})
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 4, 2, 0, 0, 0, 0, time.UTC)
+	chart, _, err := testClient.Coins.GetMarketChartRange("bitcoin", "eur", from, to)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(chart.Prices) != 1 || chart.Prices[0].Value != 23700 {
+		t.Errorf("Unexpected prices: %+v", chart.Prices)
+	}
+	if chart.Granularity != GranularityDaily {
+		t.Errorf("Granularity: %v, want daily", chart.Granularity)
+	}
+
+	if _, _, err := testClient.Coins.GetMarketChartRange("bitcoin", "eur", to, from); err == nil {
+		t.Error("Expected error for from after to")
+	}
+}
+
+func TestCoinsService_GetMarketChart_Validation(t *testing.T) {
+	c, _ := NewClient()
+	for _, days := range []string{"", "0", "-1", "week"} {
+		if _, _, err := c.Coins.GetMarketChart("bitcoin", "usd", days, GranularityAuto); err == nil {
+			t.Errorf("Expected error for days %q", days)
+		}
+	}
+	if _, _, err := c.Coins.GetMarketChart("bitcoin", "usd", "1", Granularity("weekly")); err == nil {
+		t.Error("Expected error for an unknown interval")
+	}
+
+	_, _, err := c.Coins.GetMarketChart("bitcoin", "usd", "1", GranularityHourly)
+	var planErr *PlanError
+	if !errors.As(err, &planErr) {
+		t.Errorf("Expected PlanError for the hourly interval on the public plan, got %v", err)
+	}
+}
+
+func TestMarketChartGranularity(t *testing.T) {
+	tests := []struct {
+		days     string
+		interval Granularity
+		want     Granularity
+	}{
+		{"1", GranularityAuto, Granularity5Minutely},
+		{"2", GranularityAuto, GranularityHourly},
+		{"90", GranularityAuto, GranularityHourly},
+		{"91", GranularityAuto, GranularityDaily},
+		{"max", GranularityAuto, GranularityDaily},
+		{"1", GranularityDaily, GranularityDaily},
+	}
+	for _, test := range tests {
+		got, err := marketChartGranularity(test.days, test.interval)
+		if err != nil {
+			t.Errorf("marketChartGranularity(%q, %q): %s", test.days, test.interval, err)
+		} else if got != test.want {
+			t.Errorf("marketChartGranularity(%q, %q) = %q, want %q", test.days, test.interval, got, test.want)
+		}
+	}
+}
+
+func TestPoint_UnmarshalJSON(t *testing.T) {
+	var p Point
+	if err := json.Unmarshal([]byte(`[1609459200000, "1.5"]`), &p); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !p.Time.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) || p.Value != 1.5 {
+		t.Errorf("Unexpected point: %+v", p)
+	}
+
+	for _, data := range []string{`[null, 1]`, `["", 1]`, `[1609459200000]`} {
+		if err := json.Unmarshal([]byte(data), &p); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
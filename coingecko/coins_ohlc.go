@@ -0,0 +1,93 @@
+package coingecko
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ohlcDays are the values the days parameter of the OHLC endpoint accepts
+var ohlcDays = []string{"1", "7", "14", "30", "90", "180", "365", "max"}
+
+// Candle is an OHLC candle. Time is the close time of the candle.
+type Candle struct {
+	Time  time.Time
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+// UnmarshalJSON decodes a [timestamp in milliseconds, open, high, low, close] array
+func (c *Candle) UnmarshalJSON(data []byte) error {
+	var values []float64
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if len(values) != 5 {
+		return fmt.Errorf("expected [timestamp, open, high, low, close], got %s", data)
+	}
+	*c = Candle{
+		Time:  millisecondsToTime(values[0]),
+		Open:  values[1],
+		High:  values[2],
+		Low:   values[3],
+		Close: values[4],
+	}
+	return nil
+}
+
+// GetOHLCWithContext gets the OHLC candles of a coin for the last days.
+// days must be one of 1, 7, 14, 30, 90, 180, 365 or max.
+// Candles span 30 minutes for 1-2 days, 4 hours for 3-30 days and 4 days beyond.
+// https://api.coingecko.com/api/v3/coins/{id}/ohlc
+func (s *CoinsService) GetOHLCWithContext(ctx context.Context, coinID string, vsCurrency string, days string) ([]Candle, *Response, error) {
+	if len(coinID) == 0 {
+		return nil, nil, errors.New("target coin id is required")
+	}
+	if len(vsCurrency) == 0 {
+		return nil, nil, errors.New("target currency is required")
+	}
+	if !containsString(ohlcDays, days) {
+		return nil, nil, fmt.Errorf("days must be one of %v, got %q", ohlcDays, days)
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("vs_currency", vsCurrency)
+	urlValues.Add("days", days)
+
+	u := url.URL{
+		Path:     "/coins/" + coinID + "/ohlc",
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var candles []Candle
+	resp, err := s.client.Do(req, &candles)
+	if err != nil {
+		return nil, resp, err
+	}
+	return candles, resp, nil
+}
+
+// GetOHLC wraps GetOHLCWithContext using the background context
+func (s *CoinsService) GetOHLC(coinID string, vsCurrency string, days string) ([]Candle, *Response, error) {
+	return s.GetOHLCWithContext(context.Background(), coinID, vsCurrency, days)
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
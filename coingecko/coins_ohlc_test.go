@@ -0,0 +1,41 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCoinsService_GetOHLC(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/ohlc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/coins/bitcoin/ohlc?days=7&vs_currency=usd")
+		fmt.Fprint(w, `[[1609459200000, 28900, 29100, 28800, 29000.5]]`)
+	})
+
+	candles, _, err := testClient.Coins.GetOHLC("bitcoin", "usd", "7")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := Candle{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Open: 28900, High: 29100, Low: 28800, Close: 29000.5}
+	if len(candles) != 1 || candles[0] != want {
+		t.Errorf("Candles: %+v, want [%+v]", candles, want)
+	}
+}
+
+func TestCoinsService_GetOHLC_InvalidDays(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/ohlc", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to be sent")
+	})
+
+	for _, days := range []string{"", "2", "60", "forever"} {
+		if _, _, err := testClient.Coins.GetOHLC("bitcoin", "usd", days); err == nil {
+			t.Errorf("Expected error for days %q", days)
+		}
+	}
+}
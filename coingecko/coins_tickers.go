@@ -0,0 +1,179 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// tickersPerPage is the number of tickers CoinGecko returns per page
+const tickersPerPage = 100
+
+// CoinTickers are the tickers of a coin
+type CoinTickers struct {
+	Name    string   `json:"name"`
+	Tickers []Ticker `json:"tickers"`
+}
+
+// CoinTickersOptions are the optional query parameters of the coin tickers endpoint
+type CoinTickersOptions struct {
+	// Only return tickers of these exchanges
+	ExchangeIDs []string `url:"exchange_ids,comma,omitempty"`
+
+	IncludeExchangeLogo bool   `url:"include_exchange_logo,omitempty"`
+	Page                uint16 `url:"page,omitempty"`
+	Order               string `url:"order,omitempty"`
+
+	// Include the cost to move the price up and down by 2%, see Ticker.CostToMoveUpUSD
+	Depth bool `url:"depth,omitempty"`
+}
+
+type TickersQueryOrder struct {
+	TrustScoreDesc string
+	TrustScoreAsc  string
+	VolumeDesc     string
+	VolumeAsc      string
+
+	// Only supported by the exchange tickers endpoint
+	BaseTarget string
+}
+
+var TickersQueryOrderValues = &TickersQueryOrder{
+	TrustScoreDesc: "trust_score_desc",
+	TrustScoreAsc:  "trust_score_asc",
+	VolumeDesc:     "volume_desc",
+	VolumeAsc:      "volume_asc",
+	BaseTarget:     "base_target",
+}
+
+// GetTickersWithContext gets a page of the tickers of a coin, paginated to 100 items
+// https://api.coingecko.com/api/v3/coins/{id}/tickers
+func (s *CoinsService) GetTickersWithContext(ctx context.Context, coinID string, options *CoinTickersOptions) (*CoinTickers, *Response, error) {
+	if len(coinID) == 0 {
+		return nil, nil, errors.New("target coin id is required")
+	}
+
+	u := url.URL{
+		Path: "/coins/" + coinID + "/tickers",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tickers := new(CoinTickers)
+	resp, err := s.client.Do(req, tickers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tickers, resp, nil
+}
+
+// GetTickers wraps GetTickersWithContext using the background context
+func (s *CoinsService) GetTickers(coinID string, options *CoinTickersOptions) (*CoinTickers, *Response, error) {
+	return s.GetTickersWithContext(context.Background(), coinID, options)
+}
+
+// TickersIterator returns an iterator over the tickers of a coin on all pages,
+// starting at the page set in the options, if any.
+func (s *CoinsService) TickersIterator(coinID string, options *CoinTickersOptions) *TickerIterator {
+	var pageOptions CoinTickersOptions
+	if options != nil {
+		pageOptions = *options
+	}
+	return newTickerIterator(int(pageOptions.Page), func(ctx context.Context, page int) ([]Ticker, *Response, error) {
+		pageOptions.Page = uint16(page)
+		tickers, resp, err := s.GetTickersWithContext(ctx, coinID, &pageOptions)
+		if err != nil {
+			return nil, resp, err
+		}
+		return tickers.Tickers, resp, nil
+	})
+}
+
+// TickerIterator iterates over tickers of a paginated tickers endpoint, fetching pages as needed.
+//
+//	it := client.Coins.TickersIterator("bitcoin", nil)
+//	for it.Next(ctx) {
+//		ticker := it.Ticker()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type TickerIterator struct {
+	fetch func(ctx context.Context, page int) ([]Ticker, *Response, error)
+
+	page    int
+	tickers []Ticker
+	index   int
+	resp    *Response
+	err     error
+	done    bool
+}
+
+// newTickerIterator creates a TickerIterator starting at the given page, or the first one if zero
+func newTickerIterator(page int, fetch func(ctx context.Context, page int) ([]Ticker, *Response, error)) *TickerIterator {
+	if page < 1 {
+		page = 1
+	}
+	return &TickerIterator{fetch: fetch, page: page - 1}
+}
+
+// Next advances to the next ticker, fetching the next page if needed.
+// It returns false when there are no more tickers or an error occurred, see Err.
+func (it *TickerIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.index >= len(it.tickers) {
+		if it.done {
+			return false
+		}
+		it.page++
+		tickers, resp, err := it.fetch(ctx, it.page)
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.tickers = tickers
+		it.index = 0
+		if resp != nil && resp.PerPage > 0 && resp.Total > 0 {
+			it.done = resp.NextPage == 0
+		} else {
+			it.done = len(tickers) < tickersPerPage
+		}
+	}
+	it.index++
+	return true
+}
+
+// Ticker returns the current ticker
+func (it *TickerIterator) Ticker() Ticker {
+	return it.tickers[it.index-1]
+}
+
+// Page returns the page of the current ticker
+func (it *TickerIterator) Page() int {
+	return it.page
+}
+
+// Response returns the response of the last page fetched
+func (it *TickerIterator) Response() *Response {
+	return it.resp
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *TickerIterator) Err() error {
+	return it.err
+}
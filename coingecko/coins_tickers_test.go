@@ -0,0 +1,92 @@
+package coingecko
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCoinsService_GetTickers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/tickers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		if q.Get("exchange_ids") != "binance,gdax" || q.Get("order") != "volume_desc" || q.Get("depth") != "true" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"name": "Bitcoin", "tickers": [{
+			"base": "BTC", "target": "USDT",
+			"market": {"name": "Binance", "identifier": "binance", "has_trading_incentive": false, "logo": "https://example.com/binance.png"},
+			"last": 50000, "cost_to_move_up_usd": 1234.5, "cost_to_move_down_usd": 2345.6
+		}]}`)
+	})
+
+	tickers, _, err := testClient.Coins.GetTickers("bitcoin", &CoinTickersOptions{
+		ExchangeIDs: []string{"binance", "gdax"},
+		Order:       TickersQueryOrderValues.VolumeDesc,
+		Depth:       true,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if tickers.Name != "Bitcoin" || len(tickers.Tickers) != 1 {
+		t.Fatalf("Unexpected tickers: %+v", tickers)
+	}
+	ticker := tickers.Tickers[0]
+	if ticker.Market.Logo == "" || ticker.CostToMoveUpUSD == nil || *ticker.CostToMoveDownUSD != 2345.6 {
+		t.Errorf("Unexpected ticker: %+v", ticker)
+	}
+}
+
+func TestCoinsService_TickersIterator(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/bitcoin/tickers", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Per-Page", "2")
+		w.Header().Set("Total", "3")
+		switch page {
+		case "1":
+			fmt.Fprint(w, `{"name": "Bitcoin", "tickers": [{"base": "BTC", "target": "USD"}, {"base": "BTC", "target": "EUR"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"name": "Bitcoin", "tickers": [{"base": "BTC", "target": "JPY"}]}`)
+		default:
+			t.Errorf("Unexpected page %q", page)
+			fmt.Fprint(w, `{"name": "Bitcoin", "tickers": []}`)
+		}
+	})
+
+	var targets []string
+	it := testClient.Coins.TickersIterator("bitcoin", nil)
+	for it.Next(context.Background()) {
+		targets = append(targets, it.Ticker().Target)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := strings.Join(targets, ","); got != "USD,EUR,JPY" {
+		t.Errorf("Targets: %v, want USD,EUR,JPY", got)
+	}
+	if it.Page() != 2 {
+		t.Errorf("Page: %d, want 2", it.Page())
+	}
+}
+
+func TestCoinsService_TickersIterator_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/unknown/tickers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	it := testClient.Coins.TickersIterator("unknown", nil)
+	if it.Next(context.Background()) {
+		t.Error("Expected no tickers")
+	}
+	if it.Err() == nil {
+		t.Error("Expected error")
+	}
+}
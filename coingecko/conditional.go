@@ -0,0 +1,57 @@
+package coingecko
+
+import (
+	"net/http"
+	"time"
+)
+
+// revalidateTTL is how long responses carrying an ETag or Last-Modified header are kept
+// after they turned stale, to revalidate them with a conditional request.
+const revalidateTTL = 24 * time.Hour
+
+// cacheStatus tells how a response was obtained with regard to the cache
+type cacheStatus int
+
+const (
+	// The response was received from CoinGecko
+	cacheMiss cacheStatus = iota
+
+	// The response was served from the cache without contacting CoinGecko
+	cacheHit
+
+	// The response was served from the cache after CoinGecko answered 304 Not Modified
+	cacheRevalidated
+)
+
+// hasValidators reports whether the response can be revalidated with a conditional request
+func hasValidators(r *http.Response) bool {
+	return len(r.Header.Get("ETag")) > 0 || len(r.Header.Get("Last-Modified")) > 0
+}
+
+// isConditional reports whether the request carries a conditional header
+func isConditional(req *http.Request) bool {
+	return len(req.Header.Get("If-None-Match")) > 0 || len(req.Header.Get("If-Modified-Since")) > 0
+}
+
+// setConditionalHeaders makes req conditional on the validators of the cached response.
+// Conditional headers already set on the request are kept.
+func setConditionalHeaders(req *http.Request, cached *http.Response) {
+	if etag := cached.Header.Get("ETag"); len(etag) > 0 && len(req.Header.Get("If-None-Match")) == 0 {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified := cached.Header.Get("Last-Modified"); len(lastModified) > 0 && len(req.Header.Get("If-Modified-Since")) == 0 {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+}
+
+// updateCachedHeaders refreshes the headers of the cached response with those of the 304 Not Modified response,
+// except the ones describing the (empty) body.
+func updateCachedHeaders(cached, notModified *http.Response) {
+	for key, values := range notModified.Header {
+		switch key {
+		case "Content-Length", "Content-Type", "Content-Encoding", "Transfer-Encoding":
+			continue
+		}
+		cached.Header[key] = values
+	}
+}
@@ -0,0 +1,138 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_ConditionalRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/coins/markets", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("Cache-Control", "max-age=30")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id": "bitcoin", "symbol": "btc", "current_price": 50000}]`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+	testClient.cacheTTLs["coins/markets"] = time.Nanosecond
+
+	var attempts []RetryAttempt
+	testClient.RetryPolicy = DefaultRetryPolicy()
+	testClient.RetryPolicy.OnAttempt = func(attempt RetryAttempt) {
+		attempts = append(attempts, attempt)
+	}
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		markets, resp, err := testClient.Coins.GetMarkets("usd", nil)
+		if err != nil {
+			t.Fatalf("Request %d: error given: %s", i, err)
+		}
+		if len(*markets) != 1 || (*markets)[0].CurrentPrice != 50000 {
+			t.Errorf("Request %d: unexpected markets %+v", i, markets)
+		}
+		if resp.NotModified != (i == 1) || resp.FromCache != (i == 1) {
+			t.Errorf("Request %d: NotModified %v, FromCache %v", i, resp.NotModified, resp.FromCache)
+		}
+		if i == 1 && resp.CacheMaxAge != 30*time.Second {
+			t.Errorf("Expected headers to be refreshed from the 304 response, CacheMaxAge %v", resp.CacheMaxAge)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Server calls: %d, want 2", calls)
+	}
+	if len(attempts) != 2 {
+		t.Errorf("Attempts: %d, want 2", len(attempts))
+	}
+	for _, attempt := range attempts {
+		if attempt.Err != nil {
+			t.Errorf("Expected the revalidation to be reported as a success, got %v", attempt.Err)
+		}
+	}
+}
+
+func TestClient_ConditionalRequest_NoCache(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/list", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Error("Expected no conditional request without a Cache")
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"}]`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, resp, err := testClient.Coins.List(nil); err != nil {
+			t.Fatalf("Request %d: error given: %s", i, err)
+		} else if resp.FromCache {
+			t.Errorf("Request %d: expected no response to be served from a cache", i)
+		}
+	}
+}
+
+func TestClient_ConditionalRequest_CallerConditional(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	// Without a cached response to serve, a 304 to the caller's own conditional request stays an error
+	req, _ := testClient.NewRequestWithContext(context.Background(), "GET", "ping", nil)
+	req.Header.Set("If-None-Match", `"v1"`)
+	_, err := testClient.Do(req, nil)
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected ErrorResponse for 304 Not Modified, got %v", err)
+	}
+}
+
+func TestClient_ConditionalRequest_StaleCacheEntry(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/exchange_rates", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", "Fri, 01 Jan 2021 00:00:00 GMT")
+		fmt.Fprint(w, `{"rates": {"btc": {"name": "Bitcoin", "unit": "BTC", "value": 1, "type": "crypto"}}}`)
+	})
+
+	cache, _ := NewMemoryCache(10)
+	testClient.Cache = cache
+	testClient.cacheTTLs["exchange_rates"] = time.Nanosecond
+
+	if _, _, err := testClient.ExchangeRate.GetExchangeRates(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	time.Sleep(time.Millisecond)
+	rates, resp, err := testClient.ExchangeRate.GetExchangeRates()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !resp.NotModified {
+		t.Error("Expected stale cache entry to be revalidated")
+	}
+	if rates.Rates["btc"].Value != 1 {
+		t.Errorf("Unexpected rates: %+v", rates)
+	}
+	if calls != 2 {
+		t.Errorf("Server calls: %d, want 2", calls)
+	}
+}
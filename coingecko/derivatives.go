@@ -0,0 +1,265 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// DerivativesService handles the Derivatives endpoints for CoinGecko API
+type DerivativesService struct {
+	client *Client
+}
+
+// FlexFloat64 is a number CoinGecko returns either as a JSON number or as a numeric string.
+// null and empty strings decode to zero.
+type FlexFloat64 float64
+
+// UnmarshalJSON decodes a JSON number or numeric string
+func (f *FlexFloat64) UnmarshalJSON(data []byte) error {
+	value, err := decodeNumber(data)
+	if err != nil {
+		return err
+	}
+	*f = FlexFloat64(value)
+	return nil
+}
+
+// DerivativeTicker is a derivatives contract in the derivatives tickers list
+type DerivativeTicker struct {
+	Market                   string      `json:"market"`
+	Symbol                   string      `json:"symbol"`
+	IndexID                  string      `json:"index_id"`
+	Price                    FlexFloat64 `json:"price"`
+	PricePercentageChange24H FlexFloat64 `json:"price_percentage_change_24h"`
+	ContractType             string      `json:"contract_type"`
+	Index                    FlexFloat64 `json:"index"`
+	Basis                    FlexFloat64 `json:"basis"`
+	Spread                   FlexFloat64 `json:"spread"`
+	FundingRate              FlexFloat64 `json:"funding_rate"`
+	OpenInterest             FlexFloat64 `json:"open_interest"`
+	Volume24H                FlexFloat64 `json:"volume_24h"`
+
+	// Unix timestamps in seconds, ExpiredAt is nil for perpetuals
+	LastTradedAt int64  `json:"last_traded_at"`
+	ExpiredAt    *int64 `json:"expired_at"`
+}
+
+// DerivativesExchange is an exchange in the derivatives exchanges list
+type DerivativesExchange struct {
+	ID                     string      `json:"id"`
+	Name                   string      `json:"name"`
+	OpenInterestBTC        FlexFloat64 `json:"open_interest_btc"`
+	TradeVolume24HBTC      FlexFloat64 `json:"trade_volume_24h_btc"`
+	NumberOfPerpetualPairs int         `json:"number_of_perpetual_pairs"`
+	NumberOfFuturesPairs   int         `json:"number_of_futures_pairs"`
+	Image                  string      `json:"image"`
+	YearEstablished        *int        `json:"year_established"`
+	Country                string      `json:"country"`
+	Description            string      `json:"description"`
+	URL                    string      `json:"url"`
+}
+
+// DerivativesExchangeDetail is a derivatives exchange with its details and,
+// if requested by DerivativesExchangeOptions.IncludeTickers, its tickers
+type DerivativesExchangeDetail struct {
+	DerivativesExchange
+	Tickers []DerivativesExchangeTicker `json:"tickers"`
+}
+
+// DerivativesExchangeTicker is a derivatives contract traded on a derivatives exchange
+type DerivativesExchangeTicker struct {
+	Symbol               string                 `json:"symbol"`
+	Base                 string                 `json:"base"`
+	Target               string                 `json:"target"`
+	TradeURL             string                 `json:"trade_url"`
+	ContractType         string                 `json:"contract_type"`
+	Last                 FlexFloat64            `json:"last"`
+	H24PercentageChange  FlexFloat64            `json:"h24_percentage_change"`
+	Index                FlexFloat64            `json:"index"`
+	IndexBasisPercentage FlexFloat64            `json:"index_basis_percentage"`
+	BidAskSpread         FlexFloat64            `json:"bid_ask_spread"`
+	FundingRate          FlexFloat64            `json:"funding_rate"`
+	OpenInterestUSD      FlexFloat64            `json:"open_interest_usd"`
+	H24Volume            FlexFloat64            `json:"h24_volume"`
+	ConvertedVolume      map[string]FlexFloat64 `json:"converted_volume"`
+	ConvertedLast        map[string]FlexFloat64 `json:"converted_last"`
+
+	// Unix timestamps in seconds, ExpiredAt is nil for perpetuals
+	LastTraded int64  `json:"last_traded"`
+	ExpiredAt  *int64 `json:"expired_at"`
+}
+
+// DerivativesTickersOptions are the optional query parameters of the derivatives tickers endpoint
+type DerivativesTickersOptions struct {
+	// One of DerivativesIncludeTickersValues, defaults to unexpired
+	IncludeTickers string `url:"include_tickers,omitempty"`
+}
+
+// DerivativesExchangesOptions are the optional query parameters of the derivatives exchanges endpoint
+type DerivativesExchangesOptions struct {
+	Order   string `url:"order,omitempty"`
+	PerPage uint16 `url:"per_page,omitempty"`
+	Page    uint16 `url:"page,omitempty"`
+}
+
+// DerivativesExchangeOptions are the optional query parameters of the derivatives exchange endpoint
+type DerivativesExchangeOptions struct {
+	// One of DerivativesIncludeTickersValues, tickers are left out if empty
+	IncludeTickers string `url:"include_tickers,omitempty"`
+}
+
+type DerivativesIncludeTickers struct {
+	All       string
+	Unexpired string
+}
+
+var DerivativesIncludeTickersValues = &DerivativesIncludeTickers{
+	All:       "all",
+	Unexpired: "unexpired",
+}
+
+type DerivativesExchangesQueryOrder struct {
+	NameAsc               string
+	NameDesc              string
+	OpenInterestBTCAsc    string
+	OpenInterestBTCDesc   string
+	TradeVolume24HBTCAsc  string
+	TradeVolume24HBTCDesc string
+}
+
+var DerivativesExchangesQueryOrderValues = &DerivativesExchangesQueryOrder{
+	NameAsc:               "name_asc",
+	NameDesc:              "name_desc",
+	OpenInterestBTCAsc:    "open_interest_btc_asc",
+	OpenInterestBTCDesc:   "open_interest_btc_desc",
+	TradeVolume24HBTCAsc:  "trade_volume_24h_btc_asc",
+	TradeVolume24HBTCDesc: "trade_volume_24h_btc_desc",
+}
+
+// GetTickersWithContext lists the tickers of all derivatives contracts
+// https://api.coingecko.com/api/v3/derivatives
+func (s *DerivativesService) GetTickersWithContext(ctx context.Context, options *DerivativesTickersOptions) ([]DerivativeTicker, *Response, error) {
+	u := url.URL{
+		Path: "/derivatives",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tickers []DerivativeTicker
+	resp, err := s.client.Do(req, &tickers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tickers, resp, nil
+}
+
+// GetTickers wraps GetTickersWithContext using the background context
+func (s *DerivativesService) GetTickers(options *DerivativesTickersOptions) ([]DerivativeTicker, *Response, error) {
+	return s.GetTickersWithContext(context.Background(), options)
+}
+
+// ListExchangesWithContext lists all derivatives exchanges
+// https://api.coingecko.com/api/v3/derivatives/exchanges
+func (s *DerivativesService) ListExchangesWithContext(ctx context.Context, options *DerivativesExchangesOptions) ([]DerivativesExchange, *Response, error) {
+	u := url.URL{
+		Path: "/derivatives/exchanges",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var exchanges []DerivativesExchange
+	resp, err := s.client.Do(req, &exchanges)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchanges, resp, nil
+}
+
+// ListExchanges wraps ListExchangesWithContext using the background context
+func (s *DerivativesService) ListExchanges(options *DerivativesExchangesOptions) ([]DerivativesExchange, *Response, error) {
+	return s.ListExchangesWithContext(context.Background(), options)
+}
+
+// ListExchangeIDsWithContext lists the id and name of all derivatives exchanges
+// https://api.coingecko.com/api/v3/derivatives/exchanges/list
+func (s *DerivativesService) ListExchangeIDsWithContext(ctx context.Context) ([]ExchangeListItem, *Response, error) {
+	apiEndpoint := "/derivatives/exchanges/list"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var exchanges []ExchangeListItem
+	resp, err := s.client.Do(req, &exchanges)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchanges, resp, nil
+}
+
+// ListExchangeIDs wraps ListExchangeIDsWithContext using the background context
+func (s *DerivativesService) ListExchangeIDs() ([]ExchangeListItem, *Response, error) {
+	return s.ListExchangeIDsWithContext(context.Background())
+}
+
+// GetExchangeWithContext gets the details of a derivatives exchange
+// https://api.coingecko.com/api/v3/derivatives/exchanges/{id}
+func (s *DerivativesService) GetExchangeWithContext(ctx context.Context, exchangeID string, options *DerivativesExchangeOptions) (*DerivativesExchangeDetail, *Response, error) {
+	if len(exchangeID) == 0 {
+		return nil, nil, errors.New("target exchange id is required")
+	}
+
+	u := url.URL{
+		Path: "/derivatives/exchanges/" + exchangeID,
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exchange := new(DerivativesExchangeDetail)
+	resp, err := s.client.Do(req, exchange)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchange, resp, nil
+}
+
+// GetExchange wraps GetExchangeWithContext using the background context
+func (s *DerivativesService) GetExchange(exchangeID string, options *DerivativesExchangeOptions) (*DerivativesExchangeDetail, *Response, error) {
+	return s.GetExchangeWithContext(context.Background(), exchangeID, options)
+}
@@ -0,0 +1,133 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDerivativesService_GetTickers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/derivatives", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/derivatives?include_tickers=all")
+		fmt.Fprint(w, `[{
+			"market": "Binance (Futures)", "symbol": "BTCUSDT", "index_id": "BTC", "price": "50000.5",
+			"price_percentage_change_24h": 1.5, "contract_type": "perpetual", "index": 49990.1, "basis": -0.05,
+			"spread": 0.01, "funding_rate": 0.01, "open_interest": 1000000, "volume_24h": 2000000.5,
+			"last_traded_at": 1609459200, "expired_at": null
+		}]`)
+	})
+
+	tickers, _, err := testClient.Derivatives.GetTickers(&DerivativesTickersOptions{
+		IncludeTickers: DerivativesIncludeTickersValues.All,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tickers) != 1 {
+		t.Fatalf("Unexpected tickers: %+v", tickers)
+	}
+	ticker := tickers[0]
+	if ticker.Price != 50000.5 || ticker.FundingRate != 0.01 || ticker.ContractType != "perpetual" || ticker.ExpiredAt != nil {
+		t.Errorf("Unexpected ticker: %+v", ticker)
+	}
+}
+
+func TestDerivativesService_ListExchanges(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/derivatives/exchanges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/derivatives/exchanges?order=open_interest_btc_desc&per_page=10")
+		fmt.Fprint(w, `[{
+			"name": "Binance (Futures)", "id": "binance_futures", "open_interest_btc": 279958.61,
+			"trade_volume_24h_btc": "574366.94", "number_of_perpetual_pairs": 330, "number_of_futures_pairs": 44
+		}]`)
+	})
+
+	exchanges, _, err := testClient.Derivatives.ListExchanges(&DerivativesExchangesOptions{
+		Order:   DerivativesExchangesQueryOrderValues.OpenInterestBTCDesc,
+		PerPage: 10,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(exchanges) != 1 || exchanges[0].TradeVolume24HBTC != 574366.94 || exchanges[0].NumberOfPerpetualPairs != 330 {
+		t.Errorf("Unexpected exchanges: %+v", exchanges)
+	}
+}
+
+func TestDerivativesService_ListExchangeIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/derivatives/exchanges/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": "binance_futures", "name": "Binance (Futures)"}]`)
+	})
+
+	exchanges, _, err := testClient.Derivatives.ListExchangeIDs()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(exchanges) != 1 || exchanges[0].ID != "binance_futures" {
+		t.Errorf("Unexpected exchanges: %+v", exchanges)
+	}
+}
+
+func TestDerivativesService_GetExchange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/derivatives/exchanges/binance_futures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/derivatives/exchanges/binance_futures?include_tickers=unexpired")
+		fmt.Fprint(w, `{
+			"name": "Binance (Futures)", "open_interest_btc": "1.5", "trade_volume_24h_btc": 2.5,
+			"tickers": [{
+				"symbol": "BTCUSDT", "base": "BTC", "target": "USDT", "contract_type": "perpetual", "last": 50000,
+				"funding_rate": "0.01", "open_interest_usd": 1000, "converted_volume": {"btc": "888.79", "usd": "100"},
+				"last_traded": 1609459200, "expired_at": null
+			}]
+		}`)
+	})
+
+	exchange, _, err := testClient.Derivatives.GetExchange("binance_futures", &DerivativesExchangeOptions{
+		IncludeTickers: DerivativesIncludeTickersValues.Unexpired,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if exchange.OpenInterestBTC != 1.5 || len(exchange.Tickers) != 1 {
+		t.Fatalf("Unexpected exchange: %+v", exchange)
+	}
+	if ticker := exchange.Tickers[0]; ticker.FundingRate != 0.01 || ticker.ConvertedVolume["btc"] != 888.79 {
+		t.Errorf("Unexpected ticker: %+v", ticker)
+	}
+
+	if _, _, err := testClient.Derivatives.GetExchange("", nil); err == nil {
+		t.Error("Expected error for empty exchange id")
+	}
+}
+
+func TestFlexFloat64_UnmarshalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		data string
+		want FlexFloat64
+	}{
+		{`1.5`, 1.5},
+		{`"1.5"`, 1.5},
+		{`""`, 0},
+		{`null`, 0},
+	} {
+		var got FlexFloat64
+		if err := got.UnmarshalJSON([]byte(tt.data)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalJSON(%s) = %v, %v, want %v", tt.data, got, err, tt.want)
+		}
+	}
+
+	var invalid FlexFloat64
+	if err := invalid.UnmarshalJSON([]byte(`"n/a"`)); err == nil {
+		t.Error("Expected error for a non numeric string")
+	}
+}
@@ -0,0 +1,137 @@
+package coingecko
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrorResponse reports an error caused by an API request.
+// CoinGecko returns errors either as {"error": "..."} or as
+// {"status": {"error_code": ..., "error_message": "..."}}; both shapes are decoded.
+type ErrorResponse struct {
+	// HTTP response that caused this error
+	Response *http.Response `json:"-"`
+
+	// HTTP status code of the response
+	StatusCode int `json:"-"`
+
+	// Method and URL of the request that failed
+	Method string `json:"-"`
+	URL    string `json:"-"`
+
+	// Error message returned by CoinGecko
+	Message string `json:"error"`
+
+	// Error status returned by CoinGecko
+	Status ErrorStatus `json:"status"`
+}
+
+// ErrorStatus is the status object of a CoinGecko error payload
+type ErrorStatus struct {
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Message
+	if len(msg) == 0 {
+		msg = r.Status.ErrorMessage
+	}
+	if len(msg) == 0 {
+		msg = http.StatusText(r.StatusCode)
+	}
+	return fmt.Sprintf("%v %v: %d %v", r.Method, r.URL, r.StatusCode, msg)
+}
+
+// RateLimitError occurs when CoinGecko returns 429 Too Many Requests.
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Time to wait before the next request as advertised by the Retry-After header.
+	// Zero if the header was not present.
+	RetryAfter time.Duration
+}
+
+func (r *RateLimitError) Error() string {
+	if r.RetryAfter > 0 {
+		return fmt.Sprintf("%v (retry after %v)", r.ErrorResponse.Error(), r.RetryAfter)
+	}
+	return r.ErrorResponse.Error()
+}
+
+// Unwrap returns the underlying ErrorResponse
+func (r *RateLimitError) Unwrap() error {
+	return r.ErrorResponse
+}
+
+// NotFoundError occurs when CoinGecko returns 404 Not Found, e.g. for an unknown coin id.
+type NotFoundError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying ErrorResponse
+func (r *NotFoundError) Unwrap() error {
+	return r.ErrorResponse
+}
+
+// CheckResponse checks the API response for errors, and returns them if present.
+// A response is considered an error if it has a status code outside the 200 range.
+// API error responses are expected to have either no response body, or a JSON response body that maps to ErrorResponse.
+// The returned error is an *ErrorResponse, or a *RateLimitError or *NotFoundError wrapping one.
+// The response body is restored so the caller can still read it.
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
+	if r.Request != nil {
+		errorResponse.Method = r.Request.Method
+		errorResponse.URL = redactURL(r.Request.URL)
+	}
+
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err == nil && len(data) > 0 {
+			// The body is not guaranteed to be JSON (e.g. HTML from a proxy), so decoding errors are ignored
+			_ = json.Unmarshal(data, errorResponse)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+
+	switch r.StatusCode {
+	case http.StatusTooManyRequests:
+		return &RateLimitError{
+			ErrorResponse: errorResponse,
+			RetryAfter:    parseRetryAfter(r.Header.Get("Retry-After"), time.Now()),
+		}
+	case http.StatusNotFound:
+		return &NotFoundError{ErrorResponse: errorResponse}
+	}
+	return errorResponse
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
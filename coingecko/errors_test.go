@@ -0,0 +1,75 @@
+package coingecko
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCheckResponse_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/coins/unknown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": "coin not found"}`)
+	})
+
+	_, resp, err := testClient.Coins.GetCoin("unknown", nil)
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected NotFoundError, got %#v", err)
+	}
+	if notFound.Message != "coin not found" {
+		t.Errorf("Message: %q, want %q", notFound.Message, "coin not found")
+	}
+	if notFound.Method != "GET" {
+		t.Errorf("Method: %q, want GET", notFound.Method)
+	}
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected ErrorResponse with status 404, got %#v", err)
+	}
+	if resp == nil {
+		t.Error("Expected response to be returned along with the error")
+	}
+}
+
+func TestCheckResponse_RateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"status": {"error_code": 429, "error_message": "You've exceeded the Rate Limit."}}`)
+	})
+
+	_, _, err := testClient.Util.Ping()
+	var rateLimit *RateLimitError
+	if !errors.As(err, &rateLimit) {
+		t.Fatalf("Expected RateLimitError, got %#v", err)
+	}
+	if rateLimit.RetryAfter != 30*time.Second {
+		t.Errorf("RetryAfter: %v, want 30s", rateLimit.RetryAfter)
+	}
+	if rateLimit.Status.ErrorCode != 429 {
+		t.Errorf("Status.ErrorCode: %d, want 429", rateLimit.Status.ErrorCode)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"5":                             5 * time.Second,
+		"-1":                            0,
+		"Fri, 01 Jan 2021 00:01:00 GMT": time.Minute,
+		"not a date":                    0,
+	}
+	for value, want := range tests {
+		if got := parseRetryAfter(value, now); got != want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package coingecko
 
 import (
 	"context"
-	"net/http"
 )
 
 // ExchangeRateService handles Exchange Rates for CoinGecko API
@@ -28,7 +27,7 @@ type RatesStruct struct {
 
 // GetExchangeRatesWithContext gets the BTC-to-Currency exchange rates in CoinGecko
 // https://api.coingecko.com/api/v3/exchange_rates
-func (s *ExchangeRateService) GetExchangeRatesWithContext(ctx context.Context) (*ExchangeRates, *http.Response, error) {
+func (s *ExchangeRateService) GetExchangeRatesWithContext(ctx context.Context) (*ExchangeRates, *Response, error) {
 	apiEndpoint := "/exchange_rates"
 	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
@@ -44,6 +43,6 @@ func (s *ExchangeRateService) GetExchangeRatesWithContext(ctx context.Context) (
 }
 
 // GetExchangeRates wraps GetExchangeRatesWithContext using the background context
-func (s *ExchangeRateService) GetExchangeRates() (*ExchangeRates, *http.Response, error) {
+func (s *ExchangeRateService) GetExchangeRates() (*ExchangeRates, *Response, error) {
 	return s.GetExchangeRatesWithContext(context.Background())
 }
@@ -0,0 +1,148 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ExchangesService handles the Exchanges endpoints for CoinGecko API
+type ExchangesService struct {
+	client *Client
+}
+
+// Exchange is an exchange in the exchanges list
+type Exchange struct {
+	ID                          string  `json:"id"`
+	Name                        string  `json:"name"`
+	YearEstablished             *int    `json:"year_established"`
+	Country                     string  `json:"country"`
+	Description                 string  `json:"description"`
+	URL                         string  `json:"url"`
+	Image                       string  `json:"image"`
+	HasTradingIncentive         bool    `json:"has_trading_incentive"`
+	TrustScore                  int     `json:"trust_score"`
+	TrustScoreRank              int     `json:"trust_score_rank"`
+	TradeVolume24HBTC           float64 `json:"trade_volume_24h_btc"`
+	TradeVolume24HBTCNormalized float64 `json:"trade_volume_24h_btc_normalized"`
+}
+
+// ExchangeListItem is an exchange id and name, ExchangeListItem.ID is the Ticker.Market.Identifier
+type ExchangeListItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ExchangeDetail is an exchange with its details and top 100 tickers
+type ExchangeDetail struct {
+	Name                        string   `json:"name"`
+	YearEstablished             *int     `json:"year_established"`
+	Country                     string   `json:"country"`
+	Description                 string   `json:"description"`
+	URL                         string   `json:"url"`
+	Image                       string   `json:"image"`
+	FacebookURL                 string   `json:"facebook_url"`
+	RedditURL                   string   `json:"reddit_url"`
+	TelegramURL                 string   `json:"telegram_url"`
+	SlackURL                    string   `json:"slack_url"`
+	OtherURL1                   string   `json:"other_url_1"`
+	OtherURL2                   string   `json:"other_url_2"`
+	TwitterHandle               string   `json:"twitter_handle"`
+	HasTradingIncentive         bool     `json:"has_trading_incentive"`
+	Centralized                 bool     `json:"centralized"`
+	PublicNotice                string   `json:"public_notice"`
+	AlertNotice                 string   `json:"alert_notice"`
+	TrustScore                  int      `json:"trust_score"`
+	TrustScoreRank              int      `json:"trust_score_rank"`
+	TradeVolume24HBTC           float64  `json:"trade_volume_24h_btc"`
+	TradeVolume24HBTCNormalized float64  `json:"trade_volume_24h_btc_normalized"`
+	Tickers                     []Ticker `json:"tickers"`
+}
+
+// ExchangesListOptions are the optional query parameters of the exchanges endpoint
+type ExchangesListOptions struct {
+	PerPage uint16 `url:"per_page,omitempty"`
+	Page    uint16 `url:"page,omitempty"`
+}
+
+// ListWithContext lists all exchanges with active trading volumes, paginated to 100 items by default
+// https://api.coingecko.com/api/v3/exchanges
+func (s *ExchangesService) ListWithContext(ctx context.Context, options *ExchangesListOptions) ([]Exchange, *Response, error) {
+	u := url.URL{
+		Path: "/exchanges",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var exchanges []Exchange
+	resp, err := s.client.Do(req, &exchanges)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchanges, resp, nil
+}
+
+// List wraps ListWithContext using the background context
+func (s *ExchangesService) List(options *ExchangesListOptions) ([]Exchange, *Response, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListIDsWithContext lists the id and name of all exchanges
+// https://api.coingecko.com/api/v3/exchanges/list
+func (s *ExchangesService) ListIDsWithContext(ctx context.Context) ([]ExchangeListItem, *Response, error) {
+	apiEndpoint := "/exchanges/list"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var exchanges []ExchangeListItem
+	resp, err := s.client.Do(req, &exchanges)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchanges, resp, nil
+}
+
+// ListIDs wraps ListIDsWithContext using the background context
+func (s *ExchangesService) ListIDs() ([]ExchangeListItem, *Response, error) {
+	return s.ListIDsWithContext(context.Background())
+}
+
+// GetExchangeWithContext gets the details and top 100 tickers of an exchange
+// https://api.coingecko.com/api/v3/exchanges/{id}
+func (s *ExchangesService) GetExchangeWithContext(ctx context.Context, exchangeID string) (*ExchangeDetail, *Response, error) {
+	if len(exchangeID) == 0 {
+		return nil, nil, errors.New("target exchange id is required")
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "/exchanges/"+exchangeID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exchange := new(ExchangeDetail)
+	resp, err := s.client.Do(req, exchange)
+	if err != nil {
+		return nil, resp, err
+	}
+	return exchange, resp, nil
+}
+
+// GetExchange wraps GetExchangeWithContext using the background context
+func (s *ExchangesService) GetExchange(exchangeID string) (*ExchangeDetail, *Response, error) {
+	return s.GetExchangeWithContext(context.Background(), exchangeID)
+}
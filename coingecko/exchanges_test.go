@@ -0,0 +1,69 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestExchangesService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/exchanges?page=2&per_page=50")
+		fmt.Fprint(w, `[{
+			"id": "gdax", "name": "Coinbase Exchange", "year_established": 2012, "country": "United States",
+			"trust_score": 10, "trust_score_rank": 2, "trade_volume_24h_btc": 25000.5, "trade_volume_24h_btc_normalized": 25000.5
+		}]`)
+	})
+
+	exchanges, _, err := testClient.Exchanges.List(&ExchangesListOptions{PerPage: 50, Page: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(exchanges) != 1 || *exchanges[0].YearEstablished != 2012 || exchanges[0].TrustScoreRank != 2 {
+		t.Errorf("Unexpected exchanges: %+v", exchanges)
+	}
+}
+
+func TestExchangesService_ListIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": "binance", "name": "Binance"}]`)
+	})
+
+	exchanges, _, err := testClient.Exchanges.ListIDs()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(exchanges) != 1 || exchanges[0].ID != "binance" {
+		t.Errorf("Unexpected exchanges: %+v", exchanges)
+	}
+}
+
+func TestExchangesService_GetExchange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/binance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"name": "Binance", "year_established": 2017, "country": "Cayman Islands", "centralized": true,
+			"trust_score": 10, "trust_score_rank": 1, "trade_volume_24h_btc_normalized": 100000,
+			"tickers": [{"base": "BTC", "target": "USDT", "market": {"name": "Binance", "identifier": "binance"}, "last": 50000}]
+		}`)
+	})
+
+	exchange, _, err := testClient.Exchanges.GetExchange("binance")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !exchange.Centralized || exchange.TrustScore != 10 || exchange.TradeVolume24HBTCNormalized != 100000 {
+		t.Errorf("Unexpected exchange: %+v", exchange)
+	}
+	if len(exchange.Tickers) != 1 || exchange.Tickers[0].Market.Identifier != "binance" {
+		t.Errorf("Unexpected tickers: %+v", exchange.Tickers)
+	}
+}
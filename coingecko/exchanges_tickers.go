@@ -0,0 +1,162 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// volumeChartDays are the values the days parameter of the exchange volume chart endpoint accepts
+var volumeChartDays = []string{"1", "7", "14", "30", "90", "180", "365"}
+
+// maxVolumeChartRange is the longest time span the exchange volume chart range endpoint accepts
+const maxVolumeChartRange = 31 * 24 * time.Hour
+
+// ExchangeTickers are the tickers of an exchange
+type ExchangeTickers struct {
+	Name    string   `json:"name"`
+	Tickers []Ticker `json:"tickers"`
+}
+
+// ExchangeTickersOptions are the optional query parameters of the exchange tickers endpoint
+type ExchangeTickersOptions struct {
+	// Only return tickers of these coins
+	CoinIDs []string `url:"coin_ids,comma,omitempty"`
+
+	IncludeExchangeLogo bool   `url:"include_exchange_logo,omitempty"`
+	Page                uint16 `url:"page,omitempty"`
+	Order               string `url:"order,omitempty"`
+
+	// Include the cost to move the price up and down by 2%, see Ticker.CostToMoveUpUSD
+	Depth bool `url:"depth,omitempty"`
+}
+
+// GetTickersWithContext gets a page of the tickers of an exchange, paginated to 100 items
+// https://api.coingecko.com/api/v3/exchanges/{id}/tickers
+func (s *ExchangesService) GetTickersWithContext(ctx context.Context, exchangeID string, options *ExchangeTickersOptions) (*ExchangeTickers, *Response, error) {
+	if len(exchangeID) == 0 {
+		return nil, nil, errors.New("target exchange id is required")
+	}
+
+	u := url.URL{
+		Path: "/exchanges/" + exchangeID + "/tickers",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tickers := new(ExchangeTickers)
+	resp, err := s.client.Do(req, tickers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tickers, resp, nil
+}
+
+// GetTickers wraps GetTickersWithContext using the background context
+func (s *ExchangesService) GetTickers(exchangeID string, options *ExchangeTickersOptions) (*ExchangeTickers, *Response, error) {
+	return s.GetTickersWithContext(context.Background(), exchangeID, options)
+}
+
+// TickersIterator returns an iterator over the tickers of an exchange on all pages,
+// starting at the page set in the options, if any.
+func (s *ExchangesService) TickersIterator(exchangeID string, options *ExchangeTickersOptions) *TickerIterator {
+	var pageOptions ExchangeTickersOptions
+	if options != nil {
+		pageOptions = *options
+	}
+	return newTickerIterator(int(pageOptions.Page), func(ctx context.Context, page int) ([]Ticker, *Response, error) {
+		pageOptions.Page = uint16(page)
+		tickers, resp, err := s.GetTickersWithContext(ctx, exchangeID, &pageOptions)
+		if err != nil {
+			return nil, resp, err
+		}
+		return tickers.Tickers, resp, nil
+	})
+}
+
+// GetVolumeChartWithContext gets the 24h trading volume in BTC of an exchange for the last days.
+// days must be one of 1, 7, 14, 30, 90, 180 or 365.
+// https://api.coingecko.com/api/v3/exchanges/{id}/volume_chart
+func (s *ExchangesService) GetVolumeChartWithContext(ctx context.Context, exchangeID string, days string) ([]Point, *Response, error) {
+	if len(exchangeID) == 0 {
+		return nil, nil, errors.New("target exchange id is required")
+	}
+	if !containsString(volumeChartDays, days) {
+		return nil, nil, fmt.Errorf("days must be one of %v, got %q", volumeChartDays, days)
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("days", days)
+
+	return s.getVolumeChart(ctx, "/exchanges/"+exchangeID+"/volume_chart", urlValues)
+}
+
+// GetVolumeChart wraps GetVolumeChartWithContext using the background context
+func (s *ExchangesService) GetVolumeChart(exchangeID string, days string) ([]Point, *Response, error) {
+	return s.GetVolumeChartWithContext(context.Background(), exchangeID, days)
+}
+
+// GetVolumeChartRangeWithContext gets the 24h trading volume in BTC of an exchange between two points in time,
+// at most 31 days apart. The endpoint requires the Pro plan.
+// https://pro-api.coingecko.com/api/v3/exchanges/{id}/volume_chart/range
+func (s *ExchangesService) GetVolumeChartRangeWithContext(ctx context.Context, exchangeID string, from time.Time, to time.Time) ([]Point, *Response, error) {
+	if len(exchangeID) == 0 {
+		return nil, nil, errors.New("target exchange id is required")
+	}
+	if !from.Before(to) {
+		return nil, nil, errors.New("from must be before to")
+	}
+	if to.Sub(from) > maxVolumeChartRange {
+		return nil, nil, fmt.Errorf("range must not exceed %v, got %v", maxVolumeChartRange, to.Sub(from))
+	}
+	path := "/exchanges/" + exchangeID + "/volume_chart/range"
+	if err := s.client.requirePlan(path, ProPlan); err != nil {
+		return nil, nil, err
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("from", strconv.FormatInt(from.Unix(), 10))
+	urlValues.Add("to", strconv.FormatInt(to.Unix(), 10))
+
+	return s.getVolumeChart(ctx, path, urlValues)
+}
+
+// GetVolumeChartRange wraps GetVolumeChartRangeWithContext using the background context
+func (s *ExchangesService) GetVolumeChartRange(exchangeID string, from time.Time, to time.Time) ([]Point, *Response, error) {
+	return s.GetVolumeChartRangeWithContext(context.Background(), exchangeID, from, to)
+}
+
+func (s *ExchangesService) getVolumeChart(ctx context.Context, path string, urlValues url.Values) ([]Point, *Response, error) {
+	u := url.URL{
+		Path:     path,
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var volumes []Point
+	resp, err := s.client.Do(req, &volumes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return volumes, resp, nil
+}
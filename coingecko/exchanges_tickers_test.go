@@ -0,0 +1,105 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestExchangesService_GetTickers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/binance/tickers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/exchanges/binance/tickers?coin_ids=bitcoin%2Cethereum&order=base_target")
+		fmt.Fprint(w, `{"name": "Binance", "tickers": [{"base": "BTC", "target": "USDT", "coin_id": "bitcoin"}]}`)
+	})
+
+	tickers, _, err := testClient.Exchanges.GetTickers("binance", &ExchangeTickersOptions{
+		CoinIDs: []string{"bitcoin", "ethereum"},
+		Order:   TickersQueryOrderValues.BaseTarget,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if tickers.Name != "Binance" || len(tickers.Tickers) != 1 || tickers.Tickers[0].CoinID != "bitcoin" {
+		t.Errorf("Unexpected tickers: %+v", tickers)
+	}
+}
+
+func TestExchangesService_TickersIterator(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/binance/tickers", func(w http.ResponseWriter, r *http.Request) {
+		if page := r.URL.Query().Get("page"); page != "3" {
+			t.Errorf("Unexpected page %q", page)
+		}
+		fmt.Fprint(w, `{"name": "Binance", "tickers": [{"base": "BTC", "target": "USDT"}]}`)
+	})
+
+	// A short page without pagination headers is the last one
+	it := testClient.Exchanges.TickersIterator("binance", &ExchangeTickersOptions{Page: 3})
+	count := 0
+	for it.Next(context.Background()) {
+		count++
+	}
+	if it.Err() != nil || count != 1 {
+		t.Errorf("Tickers: %d, error: %v", count, it.Err())
+	}
+}
+
+func TestExchangesService_GetVolumeChart(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/binance/volume_chart", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/exchanges/binance/volume_chart?days=1")
+		fmt.Fprint(w, `[[1609459200000.0, "306800.0517941023"], [1609459800000.0, "302561.8185582217"]]`)
+	})
+
+	volumes, _, err := testClient.Exchanges.GetVolumeChart("binance", "1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(volumes) != 2 || volumes[0].Value != 306800.0517941023 {
+		t.Errorf("Unexpected volumes: %+v", volumes)
+	}
+	if !volumes[0].Time.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected time: %v", volumes[0].Time)
+	}
+
+	if _, _, err := testClient.Exchanges.GetVolumeChart("binance", "2"); err == nil {
+		t.Error("Expected error for invalid days")
+	}
+}
+
+func TestExchangesService_GetVolumeChartRange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/exchanges/binance/volume_chart/range", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/exchanges/binance/volume_chart/range?from=1609459200&to=1609545600")
+		fmt.Fprint(w, `[[1609459200000, "1.5"]]`)
+	})
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err := testClient.Exchanges.GetVolumeChartRange("binance", from, from.Add(24*time.Hour))
+	var planErr *PlanError
+	if !errors.As(err, &planErr) {
+		t.Fatalf("Expected PlanError on the public plan, got %v", err)
+	}
+
+	testClient.plan = ProPlan
+	if _, _, err := testClient.Exchanges.GetVolumeChartRange("binance", from, from.Add(32*24*time.Hour)); err == nil {
+		t.Error("Expected error for a range over 31 days")
+	}
+	volumes, _, err := testClient.Exchanges.GetVolumeChartRange("binance", from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(volumes) != 1 || volumes[0].Value != 1.5 {
+		t.Errorf("Unexpected volumes: %+v", volumes)
+	}
+}
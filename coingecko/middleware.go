@@ -0,0 +1,136 @@
+package coingecko
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"time"
+)
+
+// RoundTripFunc sends a single HTTP request and returns its response
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of requests by a Client, e.g. to mutate requests or observe responses.
+// Middlewares run in the order they were added, the first one being the outermost.
+// They run once per attempt, after the rate limiter and before the response is checked for errors.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// defaultRequestIDHeader is the header RequestIDMiddleware sets if none is given
+const defaultRequestIDHeader = "X-Request-ID"
+
+// Use appends middlewares to the middleware chain of the client.
+// It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// roundTrip sends the request through the middleware chain
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.client.Do)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next(req)
+}
+
+// RequestMiddleware returns a Middleware which calls mutate on every request before it is sent.
+// The request is not sent if mutate returns an error.
+func RequestMiddleware(mutate func(*http.Request) error) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := mutate(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// ResponseMiddleware returns a Middleware which calls observe with every request and its outcome
+func ResponseMiddleware(observe func(*http.Request, *http.Response, error)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			observe(req, resp, err)
+			return resp, err
+		}
+	}
+}
+
+// UserAgentMiddleware sets the User-Agent header of every request
+func UserAgentMiddleware(userAgent string) Middleware {
+	return RequestMiddleware(func(req *http.Request) error {
+		req.Header.Set("User-Agent", userAgent)
+		return nil
+	})
+}
+
+// RequestIDMiddleware sets a unique ID in the given header of every request which does not have one yet.
+// The header defaults to X-Request-ID, and IDs are random hex strings unless newID is given.
+func RequestIDMiddleware(header string, newID func() string) Middleware {
+	if len(header) == 0 {
+		header = defaultRequestIDHeader
+	}
+	if newID == nil {
+		newID = randomID
+	}
+	return RequestMiddleware(func(req *http.Request) error {
+		if len(req.Header.Get(header)) == 0 {
+			req.Header.Set(header, newID())
+		}
+		return nil
+	})
+}
+
+// TimingMiddleware calls observe with every request, its response and how long the round trip took.
+// The response is nil if the request failed in transit.
+func TimingMiddleware(observe func(req *http.Request, resp *http.Response, duration time.Duration)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			observe(req, resp, time.Since(start))
+			return resp, err
+		}
+	}
+}
+
+// DebugMiddleware logs a dump of every request and response. API keys are redacted.
+func DebugMiddleware(logger Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			redactedReq := req.Clone(req.Context())
+			redactedReq.Body = nil
+			for _, plan := range []Plan{DemoPlan, ProPlan} {
+				if len(redactedReq.Header.Get(plan.apiKeyHeader())) > 0 {
+					redactedReq.Header.Set(plan.apiKeyHeader(), redacted)
+				}
+			}
+			redactedURL := redactURL(req.URL)
+			redactedReq.URL, _ = redactedReq.URL.Parse(redactedURL)
+			if dump, err := httputil.DumpRequest(redactedReq, false); err == nil {
+				logger.Printf("coingecko request:\n%s", dump)
+			}
+
+			resp, err := next(req)
+			if err != nil {
+				logger.Printf("coingecko request to %v failed: %v", redactedURL, err)
+				return resp, err
+			}
+			if dump, dumpErr := httputil.DumpResponse(resp, true); dumpErr == nil {
+				logger.Printf("coingecko response:\n%s", dump)
+			}
+			return resp, err
+		}
+	}
+}
+
+// randomID returns a random 16 byte hex string
+func randomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,99 @@
+package coingecko
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "middleware-agent" {
+			t.Errorf("User-Agent: %q, want %q", got, "middleware-agent")
+		}
+		if got := r.Header.Get("X-Request-ID"); got != "id-1" {
+			t.Errorf("X-Request-ID: %q, want %q", got, "id-1")
+		}
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	var order []string
+	var timed time.Duration
+	testClient.Use(
+		RequestMiddleware(func(req *http.Request) error {
+			order = append(order, "first")
+			return nil
+		}),
+		RequestMiddleware(func(req *http.Request) error {
+			order = append(order, "second")
+			return nil
+		}),
+		UserAgentMiddleware("middleware-agent"),
+		RequestIDMiddleware("", func() string { return "id-1" }),
+		ResponseMiddleware(func(req *http.Request, resp *http.Response, err error) {
+			order = append(order, fmt.Sprintf("response %d", resp.StatusCode))
+		}),
+		TimingMiddleware(func(req *http.Request, resp *http.Response, d time.Duration) {
+			timed = d
+		}),
+	)
+
+	if _, _, err := testClient.Util.Ping(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got, want := strings.Join(order, ","), "first,second,response 200"; got != want {
+		t.Errorf("Middleware order: %v, want %v", got, want)
+	}
+	if timed <= 0 {
+		t.Error("Expected TimingMiddleware to observe the round trip")
+	}
+}
+
+func TestClient_RequestMiddlewareError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected request not to be sent")
+	})
+
+	testClient.Use(RequestMiddleware(func(req *http.Request) error {
+		return fmt.Errorf("rejected")
+	}))
+	if _, _, err := testClient.Util.Ping(); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestDebugMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	var buf bytes.Buffer
+	if err := testClient.SetAPIKey(DemoPlan, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	testClient.Use(DebugMiddleware(log.New(&buf, "", 0)))
+	ping, _, err := testClient.Util.Ping()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if ping.GeckoSays == "" {
+		t.Error("Expected response body to still be decoded after the dump")
+	}
+	dump := buf.String()
+	if !strings.Contains(dump, "GET /ping") || !strings.Contains(dump, "To the Moon") {
+		t.Errorf("Unexpected dump: %s", dump)
+	}
+	if strings.Contains(dump, "secret") {
+		t.Errorf("Dump leaks the API key: %s", dump)
+	}
+}
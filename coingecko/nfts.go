@@ -0,0 +1,180 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// NFTService handles the NFTs endpoints for CoinGecko API
+type NFTService struct {
+	client *Client
+}
+
+// NFTListItem is an NFT collection in the NFT collections list
+type NFTListItem struct {
+	ID              string `json:"id"`
+	ContractAddress string `json:"contract_address"`
+	Name            string `json:"name"`
+	AssetPlatformID string `json:"asset_platform_id"`
+	Symbol          string `json:"symbol"`
+}
+
+// NFTPrice is an amount in the native currency of an NFT collection's platform and in USD
+type NFTPrice struct {
+	NativeCurrency float64 `json:"native_currency"`
+	USD            float64 `json:"usd"`
+}
+
+// NFT is an NFT collection with its market data
+type NFT struct {
+	ID                   string `json:"id"`
+	ContractAddress      string `json:"contract_address"`
+	AssetPlatformID      string `json:"asset_platform_id"`
+	Name                 string `json:"name"`
+	Symbol               string `json:"symbol"`
+	Description          string `json:"description"`
+	NativeCurrency       string `json:"native_currency"`
+	NativeCurrencySymbol string `json:"native_currency_symbol"`
+	Image                struct {
+		Small string `json:"small"`
+	} `json:"image"`
+
+	FloorPrice  NFTPrice `json:"floor_price"`
+	MarketCap   NFTPrice `json:"market_cap"`
+	Volume24H   NFTPrice `json:"volume_24h"`
+	TotalSupply float64  `json:"total_supply"`
+
+	// Number of unique holders
+	NumberOfUniqueAddresses                    int     `json:"number_of_unique_addresses"`
+	NumberOfUniqueAddresses24HPercentageChange float64 `json:"number_of_unique_addresses_24h_percentage_change"`
+
+	FloorPrice24HPercentageChange NFTPrice `json:"floor_price_24h_percentage_change"`
+	FloorPrice7DPercentageChange  NFTPrice `json:"floor_price_7d_percentage_change"`
+	FloorPrice14DPercentageChange NFTPrice `json:"floor_price_14d_percentage_change"`
+	FloorPrice30DPercentageChange NFTPrice `json:"floor_price_30d_percentage_change"`
+	FloorPrice60DPercentageChange NFTPrice `json:"floor_price_60d_percentage_change"`
+	FloorPrice1YPercentageChange  NFTPrice `json:"floor_price_1y_percentage_change"`
+	MarketCap24HPercentageChange  NFTPrice `json:"market_cap_24h_percentage_change"`
+	Volume24HPercentageChange     NFTPrice `json:"volume_24h_percentage_change"`
+
+	Links struct {
+		Homepage string `json:"homepage"`
+		Twitter  string `json:"twitter"`
+		Discord  string `json:"discord"`
+	} `json:"links"`
+}
+
+// NFTListOptions are the optional query parameters of the NFT collections list endpoint
+type NFTListOptions struct {
+	Order           string `url:"order,omitempty"`
+	AssetPlatformID string `url:"asset_platform_id,omitempty"`
+	PerPage         uint16 `url:"per_page,omitempty"`
+	Page            uint16 `url:"page,omitempty"`
+}
+
+type NFTQueryOrder struct {
+	H24VolumeNativeAsc   string
+	H24VolumeNativeDesc  string
+	H24VolumeUSDAsc      string
+	H24VolumeUSDDesc     string
+	FloorPriceNativeAsc  string
+	FloorPriceNativeDesc string
+	MarketCapNativeAsc   string
+	MarketCapNativeDesc  string
+	MarketCapUSDAsc      string
+	MarketCapUSDDesc     string
+}
+
+var NFTQueryOrderValues = &NFTQueryOrder{
+	H24VolumeNativeAsc:   "h24_volume_native_asc",
+	H24VolumeNativeDesc:  "h24_volume_native_desc",
+	H24VolumeUSDAsc:      "h24_volume_usd_asc",
+	H24VolumeUSDDesc:     "h24_volume_usd_desc",
+	FloorPriceNativeAsc:  "floor_price_native_asc",
+	FloorPriceNativeDesc: "floor_price_native_desc",
+	MarketCapNativeAsc:   "market_cap_native_asc",
+	MarketCapNativeDesc:  "market_cap_native_desc",
+	MarketCapUSDAsc:      "market_cap_usd_asc",
+	MarketCapUSDDesc:     "market_cap_usd_desc",
+}
+
+// ListWithContext lists the NFT collections, paginated to 100 items by default
+// https://api.coingecko.com/api/v3/nfts/list
+func (s *NFTService) ListWithContext(ctx context.Context, options *NFTListOptions) ([]NFTListItem, *Response, error) {
+	u := url.URL{
+		Path: "/nfts/list",
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nfts []NFTListItem
+	resp, err := s.client.Do(req, &nfts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return nfts, resp, nil
+}
+
+// List wraps ListWithContext using the background context
+func (s *NFTService) List(options *NFTListOptions) ([]NFTListItem, *Response, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// GetNFTWithContext gets the market data of an NFT collection
+// https://api.coingecko.com/api/v3/nfts/{id}
+func (s *NFTService) GetNFTWithContext(ctx context.Context, nftID string) (*NFT, *Response, error) {
+	if len(nftID) == 0 {
+		return nil, nil, errors.New("target nft id is required")
+	}
+	return s.getNFT(ctx, "/nfts/"+nftID)
+}
+
+// GetNFT wraps GetNFTWithContext using the background context
+func (s *NFTService) GetNFT(nftID string) (*NFT, *Response, error) {
+	return s.GetNFTWithContext(context.Background(), nftID)
+}
+
+// GetNFTByContractWithContext gets the market data of an NFT collection by its contract address on an asset platform
+// https://api.coingecko.com/api/v3/nfts/{asset_platform_id}/contract/{contract_address}
+func (s *NFTService) GetNFTByContractWithContext(ctx context.Context, platformID string, contractAddress string) (*NFT, *Response, error) {
+	if len(platformID) == 0 {
+		return nil, nil, errors.New("asset platform id is required")
+	}
+	if len(contractAddress) == 0 {
+		return nil, nil, errors.New("contract address is required")
+	}
+	return s.getNFT(ctx, "/nfts/"+url.PathEscape(platformID)+"/contract/"+url.PathEscape(contractAddress))
+}
+
+// GetNFTByContract wraps GetNFTByContractWithContext using the background context
+func (s *NFTService) GetNFTByContract(platformID string, contractAddress string) (*NFT, *Response, error) {
+	return s.GetNFTByContractWithContext(context.Background(), platformID, contractAddress)
+}
+
+func (s *NFTService) getNFT(ctx context.Context, path string) (*NFT, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nft := new(NFT)
+	resp, err := s.client.Do(req, nft)
+	if err != nil {
+		return nil, resp, err
+	}
+	return nft, resp, nil
+}
@@ -0,0 +1,103 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testNFTJSON = `{
+	"id": "pudgy-penguins", "contract_address": "0xbd3531da5cf5857e7cfaa92426877b022e612cf8",
+	"asset_platform_id": "ethereum", "name": "Pudgy Penguins", "symbol": "PPG",
+	"native_currency": "ethereum", "native_currency_symbol": "ETH",
+	"floor_price": {"native_currency": 12.5, "usd": 42000.5},
+	"market_cap": {"native_currency": 111000, "usd": 373000000},
+	"volume_24h": {"native_currency": 400, "usd": 1340000},
+	"number_of_unique_addresses": 4750, "number_of_unique_addresses_24h_percentage_change": 0.08,
+	"floor_price_24h_percentage_change": {"usd": 1.07, "native_currency": 1.5},
+	"floor_price_7d_percentage_change": {"usd": -18, "native_currency": -13.3},
+	"total_supply": 8888
+}`
+
+func TestNFTService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/nfts/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/nfts/list?asset_platform_id=ethereum&order=market_cap_usd_desc&page=2&per_page=50")
+		fmt.Fprint(w, `[{"id": "pudgy-penguins", "contract_address": "0xbd3531da5cf5857e7cfaa92426877b022e612cf8", "name": "Pudgy Penguins", "asset_platform_id": "ethereum", "symbol": "PPG"}]`)
+	})
+
+	nfts, _, err := testClient.NFTs.List(&NFTListOptions{
+		Order:           NFTQueryOrderValues.MarketCapUSDDesc,
+		AssetPlatformID: "ethereum",
+		PerPage:         50,
+		Page:            2,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(nfts) != 1 || nfts[0].ID != "pudgy-penguins" || nfts[0].AssetPlatformID != "ethereum" {
+		t.Errorf("Unexpected nfts: %+v", nfts)
+	}
+}
+
+func TestNFTService_GetNFT(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/nfts/pudgy-penguins", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testNFTJSON)
+	})
+
+	nft, _, err := testClient.NFTs.GetNFT("pudgy-penguins")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if nft.FloorPrice.NativeCurrency != 12.5 || nft.FloorPrice.USD != 42000.5 || nft.Volume24H.USD != 1340000 {
+		t.Errorf("Unexpected prices: %+v", nft)
+	}
+	if nft.NumberOfUniqueAddresses != 4750 || nft.FloorPrice24HPercentageChange.NativeCurrency != 1.5 || nft.FloorPrice7DPercentageChange.USD != -18 {
+		t.Errorf("Unexpected holders or changes: %+v", nft)
+	}
+
+	if _, _, err := testClient.NFTs.GetNFT(""); err == nil {
+		t.Error("Expected error for empty nft id")
+	}
+}
+
+func TestNFTService_GetNFTByContract(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/nfts/ethereum/contract/0xbd3531da5cf5857e7cfaa92426877b022e612cf8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testNFTJSON)
+	})
+
+	nft, _, err := testClient.NFTs.GetNFTByContract("ethereum", "0xbd3531da5cf5857e7cfaa92426877b022e612cf8")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if nft.ID != "pudgy-penguins" || nft.MarketCap.USD != 373000000 {
+		t.Errorf("Unexpected nft: %+v", nft)
+	}
+
+	if _, _, err := testClient.NFTs.GetNFTByContract("ethereum", ""); err == nil {
+		t.Error("Expected error for empty contract address")
+	}
+}
+
+func TestNFTService_GetNFTByContract_Escaping(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/nfts/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/nfts/ethereum/contract/0xabc%2Fdef%3Fx"; got != want {
+			t.Errorf("Request path: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, testNFTJSON)
+	})
+
+	if _, _, err := testClient.NFTs.GetNFTByContract("ethereum", "0xabc/def?x"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
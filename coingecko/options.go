@@ -0,0 +1,153 @@
+package coingecko
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created by NewClient
+type Option func(*Client) error
+
+// Logger is used by the Client to report retries and other notable events.
+// *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the API
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("HTTP client must not be nil")
+		}
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL for API requests. A trailing slash is added if missing.
+// It takes precedence over the base URL implied by WithAPIKey.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("base URL must be an absolute http(s) URL, got %q", baseURL)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithAPIKey sets the API key sent with every request and the plan it belongs to.
+// See Client.SetAPIKey.
+func WithAPIKey(plan Plan, apiKey string) Option {
+	return func(c *Client) error {
+		return c.SetAPIKey(plan, apiKey)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		if len(userAgent) == 0 {
+			return errors.New("user agent must not be empty")
+		}
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client, including one set by WithHTTPClient.
+// The HTTP client passed to WithHTTPClient is copied rather than modified.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %v", timeout)
+		}
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter every request waits on
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) error {
+		if limiter == nil {
+			return errors.New("rate limiter must not be nil")
+		}
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying failed requests
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy == nil {
+			return errors.New("retry policy must not be nil")
+		}
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithLogger sets the logger used to report retries and other notable events
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCache sets the cache responses to GET requests are stored in
+func WithCache(cache Cache) Option {
+	return func(c *Client) error {
+		if cache == nil {
+			return errors.New("cache must not be nil")
+		}
+		c.Cache = cache
+		return nil
+	}
+}
+
+// WithCacheTTL sets the cache TTL for an endpoint, given as its path relative to the base URL,
+// e.g. "coins/markets". The TTL applies to all endpoints below the path unless they have their own.
+// A zero TTL disables caching for the endpoint.
+func WithCacheTTL(endpoint string, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl < 0 {
+			return fmt.Errorf("cache TTL must not be negative, got %v", ttl)
+		}
+		c.cacheTTLs[strings.Trim(endpoint, "/")] = ttl
+		return nil
+	}
+}
+
+// WithMiddleware appends middlewares to the middleware chain of the client
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) error {
+		for _, middleware := range middlewares {
+			if middleware == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		c.Use(middlewares...)
+		return nil
+	}
+}
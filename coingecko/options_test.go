@@ -0,0 +1,116 @@
+package coingecko
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient_Defaults(t *testing.T) {
+	c, err := NewClient()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := c.BaseURL.String(); got != defaultBaseURL {
+		t.Errorf("BaseURL: %v, want %v", got, defaultBaseURL)
+	}
+	if c.UserAgent != defaultUserAgent {
+		t.Errorf("UserAgent: %v, want %v", c.UserAgent, defaultUserAgent)
+	}
+}
+
+func TestNewClient_InvalidOptions(t *testing.T) {
+	tests := map[string]Option{
+		"nil http client":    WithHTTPClient(nil),
+		"relative base url":  WithBaseURL("/api/v3"),
+		"bad scheme":         WithBaseURL("ftp://api.coingecko.com"),
+		"empty api key":      WithAPIKey(ProPlan, ""),
+		"public api key":     WithAPIKey(PublicPlan, "key"),
+		"empty user agent":   WithUserAgent(""),
+		"zero timeout":       WithTimeout(0),
+		"nil rate limiter":   WithRateLimiter(nil),
+		"nil retry policy":   WithRetryPolicy(nil),
+		"bad retry attempts": WithRetryPolicy(&RetryPolicy{}),
+		"bad retry jitter":   WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, Jitter: 2}),
+		"nil logger":         WithLogger(nil),
+	}
+	for name, opt := range tests {
+		if _, err := NewClient(opt); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestNewClient_BaseURL(t *testing.T) {
+	c, err := NewClient(WithBaseURL("https://example.com/api/v3"), WithAPIKey(ProPlan, "key"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got, want := c.BaseURL.String(), "https://example.com/api/v3/"; got != want {
+		t.Errorf("BaseURL: %v, want %v", got, want)
+	}
+
+	c, err = NewClient(WithAPIKey(ProPlan, "key"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := c.BaseURL.String(); got != proBaseURL {
+		t.Errorf("BaseURL: %v, want %v", got, proBaseURL)
+	}
+}
+
+func TestNewClient_Timeout(t *testing.T) {
+	httpClient := &http.Client{}
+	c, err := NewClient(WithTimeout(time.Second), WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if c.client.Timeout != time.Second {
+		t.Errorf("Timeout: %v, want 1s", c.client.Timeout)
+	}
+	if httpClient.Timeout != 0 {
+		t.Error("Expected the given HTTP client not to be modified")
+	}
+}
+
+func TestNewClient_UserAgentAndLogger(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if got := r.Header.Get("User-Agent"); got != "test-agent" {
+			t.Errorf("User-Agent: %q, want %q", got, "test-agent")
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	var buf bytes.Buffer
+	c, err := NewClient(
+		WithBaseURL(testServer.URL),
+		WithUserAgent("test-agent"),
+		WithAPIKey(DemoPlan, "secret"),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+		WithLogger(log.New(&buf, "", 0)),
+	)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := c.Util.Ping(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !strings.Contains(buf.String(), "retrying") {
+		t.Errorf("Expected retry to be logged, got %q", buf.String())
+	}
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("Log leaks the API key: %q", buf.String())
+	}
+}
@@ -0,0 +1,151 @@
+package coingecko
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const proBaseURL = "https://pro-api.coingecko.com/api/v3/"
+
+// Plan is a CoinGecko API plan
+type Plan int
+
+// Available CoinGecko API plans
+const (
+	// PublicPlan is the keyless public API
+	PublicPlan Plan = iota
+
+	// DemoPlan is the free plan authenticated with a demo API key
+	DemoPlan
+
+	// ProPlan is any paid plan, served from the pro API host
+	ProPlan
+)
+
+// Query parameters CoinGecko accepts as an alternative to the API key headers
+var apiKeyQueryParams = []string{"x_cg_pro_api_key", "x_cg_demo_api_key"}
+
+const redacted = "REDACTED"
+
+func (p Plan) String() string {
+	switch p {
+	case PublicPlan:
+		return "public"
+	case DemoPlan:
+		return "demo"
+	case ProPlan:
+		return "pro"
+	}
+	return fmt.Sprintf("Plan(%d)", int(p))
+}
+
+// RateLimit returns the rate limit preset for the plan
+func (p Plan) RateLimit() RateLimit {
+	switch p {
+	case DemoPlan:
+		return DemoRateLimit
+	case ProPlan:
+		return ProRateLimit
+	}
+	return PublicRateLimit
+}
+
+// apiKeyHeader returns the header the API key of the plan is sent in
+func (p Plan) apiKeyHeader() string {
+	if p == ProPlan {
+		return "x-cg-pro-api-key"
+	}
+	return "x-cg-demo-api-key"
+}
+
+// PlanError occurs when an endpoint is called which is not available on the plan of the Client.
+// It is returned before any request is sent.
+type PlanError struct {
+	Endpoint string
+	Plan     Plan
+	Required Plan
+}
+
+func (e *PlanError) Error() string {
+	return fmt.Sprintf("%v requires the %v plan, client is on the %v plan", e.Endpoint, e.Required, e.Plan)
+}
+
+// Plan returns the API plan of the client
+func (c *Client) Plan() Plan {
+	return c.plan
+}
+
+// SetAPIKey sets the API key sent with every request and the plan it belongs to.
+// The BaseURL is switched to the pro API host for the Pro plan and to the public API host
+// for the Demo plan, unless it was set to another URL, e.g. by WithBaseURL.
+func (c *Client) SetAPIKey(plan Plan, apiKey string) error {
+	if plan != DemoPlan && plan != ProPlan {
+		return fmt.Errorf("an API key requires the demo or pro plan, got %v", plan)
+	}
+	if len(apiKey) == 0 {
+		return errors.New("API key is required")
+	}
+
+	if c.BaseURL == nil || c.BaseURL.String() == defaultBaseURL || c.BaseURL.String() == proBaseURL {
+		planBaseURL := defaultBaseURL
+		if plan == ProPlan {
+			planBaseURL = proBaseURL
+		}
+		c.BaseURL, _ = url.Parse(planBaseURL)
+	}
+	c.plan = plan
+	c.apiKey = apiKey
+	return nil
+}
+
+// requirePlan returns a PlanError if the client is not on the required plan.
+func (c *Client) requirePlan(endpoint string, required Plan) error {
+	if c.plan < required {
+		return &PlanError{Endpoint: endpoint, Plan: c.plan, Required: required}
+	}
+	return nil
+}
+
+// redact replaces the API key of the client in s.
+func (c *Client) redact(s string) string {
+	if len(c.apiKey) == 0 {
+		return s
+	}
+	return strings.Replace(s, c.apiKey, redacted, -1)
+}
+
+// redactURL returns the URL as a string with any API key query parameter redacted.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	found := false
+	for _, param := range apiKeyQueryParams {
+		if _, ok := q[param]; ok {
+			q.Set(param, redacted)
+			found = true
+		}
+	}
+	if !found {
+		return u.String()
+	}
+	redactedURL := *u
+	redactedURL.RawQuery = q.Encode()
+	return redactedURL.String()
+}
+
+// redactError removes the API key from errors returned while sending a request.
+func (c *Client) redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			urlErr.URL = redactURL(u)
+		}
+		urlErr.URL = c.redact(urlErr.URL)
+	}
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		errorResponse.URL = c.redact(errorResponse.URL)
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestClient_SetAPIKey(t *testing.T) {
+	c, _ := NewClient()
+	if err := c.SetAPIKey(PublicPlan, "key"); err == nil {
+		t.Error("Expected error for an API key on the public plan")
+	}
+	if err := c.SetAPIKey(ProPlan, ""); err == nil {
+		t.Error("Expected error for an empty API key")
+	}
+
+	if err := c.SetAPIKey(ProPlan, "secret"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := c.BaseURL.String(); got != proBaseURL {
+		t.Errorf("BaseURL: %v, want %v", got, proBaseURL)
+	}
+	if c.Plan() != ProPlan {
+		t.Errorf("Plan: %v, want %v", c.Plan(), ProPlan)
+	}
+
+	// Switching back to the Demo plan moves back to the public API host
+	if err := c.SetAPIKey(DemoPlan, "secret"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := c.BaseURL.String(); got != defaultBaseURL {
+		t.Errorf("BaseURL: %v, want %v", got, defaultBaseURL)
+	}
+}
+
+func TestClient_SetAPIKey_CustomBaseURL(t *testing.T) {
+	c, _ := NewClient(WithBaseURL("https://example.com/api/v3"))
+	for _, plan := range []Plan{ProPlan, DemoPlan} {
+		if err := c.SetAPIKey(plan, "secret"); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if got, want := c.BaseURL.String(), "https://example.com/api/v3/"; got != want {
+			t.Errorf("%v plan: BaseURL %v, want %v", plan, got, want)
+		}
+	}
+}
+
+func TestClient_APIKeyHeader(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("x-cg-demo-api-key"); got != "secret" {
+			t.Errorf("x-cg-demo-api-key header: %q, want %q", got, "secret")
+		}
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	if err := testClient.SetAPIKey(DemoPlan, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := testClient.Util.Ping(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestClient_RedactsAPIKey(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("x_cg_pro_api_key"); got != "secret" {
+			t.Errorf("Expected the API key in the request URL, got %q", got)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if err := testClient.SetAPIKey(ProPlan, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	req, err := testClient.NewRequestWithContext(context.Background(), "GET", "ping?x_cg_pro_api_key=secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testClient.Do(req, nil)
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Expected ErrorResponse, got %v", err)
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Error leaks the API key: %s", err)
+	}
+	if !strings.Contains(errorResponse.URL, "x_cg_pro_api_key=REDACTED") {
+		t.Errorf("Expected the API key parameter to be redacted, got %s", errorResponse.URL)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://pro-api.coingecko.com/api/v3/ping?x_cg_pro_api_key=secret&ids=bitcoin")
+	got := redactURL(u)
+	if strings.Contains(got, "secret") || !strings.Contains(got, "ids=bitcoin") {
+		t.Errorf("redactURL() = %s", got)
+	}
+
+	u, _ = url.Parse("https://api.coingecko.com/api/v3/ping?x_cg_demo_api_key=secret")
+	if got := redactURL(u); strings.Contains(got, "secret") {
+		t.Errorf("redactURL() = %s", got)
+	}
+}
+
+func TestClient_RedactError(t *testing.T) {
+	c, _ := NewClient()
+	if err := c.SetAPIKey(ProPlan, "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	urlErr := c.redactError(&url.Error{
+		Op:  "Get",
+		URL: "https://pro-api.coingecko.com/api/v3/ping?x_cg_pro_api_key=secret",
+		Err: errors.New("connection refused"),
+	})
+	if strings.Contains(urlErr.Error(), "secret") {
+		t.Errorf("url.Error leaks the API key: %s", urlErr)
+	}
+
+	// The key may also show up outside of the query, e.g. echoed in a path
+	responseErr := c.redactError(&ErrorResponse{
+		Method:     "GET",
+		URL:        "https://pro-api.coingecko.com/api/v3/secret",
+		StatusCode: http.StatusUnauthorized,
+	})
+	if strings.Contains(responseErr.Error(), "secret") {
+		t.Errorf("ErrorResponse leaks the API key: %s", responseErr)
+	}
+}
+
+func TestClient_RequirePlan(t *testing.T) {
+	c, _ := NewClient()
+	err := c.requirePlan("/coins/list/new", ProPlan)
+	var planErr *PlanError
+	if !errors.As(err, &planErr) {
+		t.Fatalf("Expected PlanError, got %v", err)
+	}
+	if planErr.Plan != PublicPlan || planErr.Required != ProPlan {
+		t.Errorf("Unexpected PlanError: %+v", planErr)
+	}
+}
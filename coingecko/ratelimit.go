@@ -0,0 +1,114 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles requests sent by a Client.
+// Implementations must be safe for concurrent use, so a single RateLimiter
+// can be shared by several Clients drawing from the same API budget.
+type RateLimiter interface {
+	// Wait blocks until a request may be sent, or returns an error if the context
+	// is done or its deadline would pass before the request may be sent.
+	Wait(ctx context.Context) error
+}
+
+// RateLimit is a request budget expressed in requests per minute,
+// with Burst requests allowed to be sent back to back.
+type RateLimit struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// Rate limit presets for the CoinGecko API plans
+var (
+	PublicRateLimit = RateLimit{RequestsPerMinute: 10, Burst: 1}
+	DemoRateLimit   = RateLimit{RequestsPerMinute: 30, Burst: 5}
+	ProRateLimit    = RateLimit{RequestsPerMinute: 500, Burst: 25}
+)
+
+// TokenBucket is a token bucket RateLimiter.
+// The bucket holds up to Burst tokens and is refilled at RequestsPerMinute.
+type TokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewRateLimiter creates a TokenBucket for the given rate limit.
+// The bucket starts full. A Burst below 1 is treated as 1.
+func NewRateLimiter(limit RateLimit) (*TokenBucket, error) {
+	if limit.RequestsPerMinute <= 0 {
+		return nil, errors.New("requests per minute must be positive")
+	}
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		interval: time.Minute / time.Duration(limit.RequestsPerMinute),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}, nil
+}
+
+// Wait takes a token from the bucket, blocking until one is available.
+// If the context has a deadline that would pass before a token is available,
+// Wait returns immediately with an error wrapping context.DeadlineExceeded.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.refill(now)
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens * float64(b.interval))
+	}
+	if deadline, ok := ctx.Deadline(); ok && wait > 0 && now.Add(wait).After(deadline) {
+		b.tokens++
+		b.mu.Unlock()
+		return fmt.Errorf("rate limiter wait of %v exceeds context deadline: %w", wait, context.DeadlineExceeded)
+	}
+	b.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Hand the reserved token back so the budget is not wasted
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// refill adds the tokens accumulated since the last call. b.mu must be held.
+func (b *TokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last)
+	if elapsed <= 0 {
+		return
+	}
+	b.last = now
+	b.tokens += float64(elapsed) / float64(b.interval)
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
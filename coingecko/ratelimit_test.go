@@ -0,0 +1,68 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type countingRateLimiter struct {
+	calls int
+}
+
+func (l *countingRateLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return nil
+}
+
+func TestNewRateLimiter_Invalid(t *testing.T) {
+	if _, err := NewRateLimiter(RateLimit{}); err == nil {
+		t.Error("Expected error for zero requests per minute")
+	}
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerMinute: 60, Burst: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Burst wait %d: %s", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = limiter.Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected Wait to fail fast, took %v", elapsed)
+	}
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	limiter := &countingRateLimiter{}
+	testClient.RateLimiter = limiter
+	for i := 0; i < 3; i++ {
+		if _, _, err := testClient.Util.Ping(); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if limiter.calls != 3 {
+		t.Errorf("RateLimiter.Wait calls: %d, want 3", limiter.calls)
+	}
+}
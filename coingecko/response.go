@@ -0,0 +1,151 @@
+package coingecko
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Response is a CoinGecko API response.
+// It wraps the standard http.Response and exposes metadata parsed from its headers.
+// Fields are left at their zero value when the corresponding header was not provided.
+type Response struct {
+	*http.Response
+
+	// Whether the response was served from the Cache of the Client.
+	// NotModified is set if CoinGecko confirmed the cached response with 304 Not Modified.
+	FromCache   bool
+	NotModified bool
+
+	// Rate limit of the API key as reported by the X-RateLimit-* headers
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+
+	// Caching headers. CacheMaxAge is the max-age directive of the Cache-Control header.
+	CacheMaxAge  time.Duration
+	Age          time.Duration
+	ETag         string
+	LastModified time.Time
+
+	// Time the response was generated by the server, from the Date header
+	Date time.Time
+
+	// Pagination of paginated endpoints. Page is the requested page,
+	// PerPage and Total come from the per-page and total headers.
+	// NextPage and LastPage come from the Link header, or are derived from Total.
+	// NextPage is zero on the last page.
+	Page     int
+	PerPage  int
+	Total    int
+	NextPage int
+	LastPage int
+}
+
+// newResponse creates a new Response for the provided http.Response.
+// r must not be nil.
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+	response.populateRateLimit()
+	response.populateCaching()
+	response.populatePagination()
+	return response
+}
+
+// NextPollIn returns how long the response stays fresh on the server, i.e. the
+// time after which polling the endpoint again may return new data.
+// It is zero if the response did not carry caching headers.
+func (r *Response) NextPollIn() time.Duration {
+	if r.CacheMaxAge <= r.Age {
+		return 0
+	}
+	return r.CacheMaxAge - r.Age
+}
+
+func (r *Response) populateRateLimit() {
+	r.RateLimitLimit = headerInt(r.Header, "X-RateLimit-Limit")
+	r.RateLimitRemaining = headerInt(r.Header, "X-RateLimit-Remaining")
+	if reset := r.Header.Get("X-RateLimit-Reset"); len(reset) > 0 {
+		if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			r.RateLimitReset = time.Unix(seconds, 0)
+		} else if t, err := time.Parse(time.RFC3339, reset); err == nil {
+			r.RateLimitReset = t
+		}
+	}
+}
+
+func (r *Response) populateCaching() {
+	for _, directive := range strings.Split(r.Header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		if strings.HasPrefix(directive, "max-age=") {
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				r.CacheMaxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	r.Age = time.Duration(headerInt(r.Header, "Age")) * time.Second
+	r.ETag = r.Header.Get("ETag")
+	if t, err := http.ParseTime(r.Header.Get("Last-Modified")); err == nil {
+		r.LastModified = t
+	}
+	if t, err := http.ParseTime(r.Header.Get("Date")); err == nil {
+		r.Date = t
+	}
+}
+
+func (r *Response) populatePagination() {
+	r.PerPage = headerInt(r.Header, "Per-Page")
+	r.Total = headerInt(r.Header, "Total")
+	if r.Request != nil {
+		if page, err := strconv.Atoi(r.Request.URL.Query().Get("page")); err == nil {
+			r.Page = page
+		}
+	}
+
+	// Link: <https://api.coingecko.com/api/v3/coins/bitcoin/tickers?page=2>; rel="next", <...>; rel="last"
+	for _, link := range strings.Split(r.Header.Get("Link"), ",") {
+		segments := strings.Split(strings.TrimSpace(link), ";")
+		if len(segments) < 2 {
+			continue
+		}
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(segments[0]), "<>"))
+		if err != nil {
+			continue
+		}
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+		for _, segment := range segments[1:] {
+			switch strings.TrimSpace(segment) {
+			case `rel="next"`:
+				r.NextPage = page
+			case `rel="last"`:
+				r.LastPage = page
+			}
+		}
+	}
+
+	if r.PerPage > 0 && r.Total > 0 {
+		if r.Page == 0 {
+			r.Page = 1
+		}
+		if r.LastPage == 0 {
+			r.LastPage = (r.Total + r.PerPage - 1) / r.PerPage
+		}
+		if r.NextPage == 0 && r.Page < r.LastPage {
+			r.NextPage = r.Page + 1
+		}
+	}
+}
+
+// headerInt parses an integer header, returning zero if it is missing or malformed.
+func headerInt(header http.Header, key string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(header.Get(key)))
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,60 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResponse_Metadata(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "499")
+		w.Header().Set("X-RateLimit-Reset", "1609459200")
+		w.Header().Set("Cache-Control", "public, max-age=30")
+		w.Header().Set("Age", "10")
+		w.Header().Set("ETag", `W/"abc"`)
+		w.Header().Set("Per-Page", "100")
+		w.Header().Set("Total", "250")
+		fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+	})
+
+	_, resp, err := testClient.Util.Ping()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.RateLimitLimit != 500 || resp.RateLimitRemaining != 499 {
+		t.Errorf("Rate limit: %d/%d, want 499/500", resp.RateLimitRemaining, resp.RateLimitLimit)
+	}
+	if !resp.RateLimitReset.Equal(time.Unix(1609459200, 0)) {
+		t.Errorf("RateLimitReset: %v", resp.RateLimitReset)
+	}
+	if resp.CacheMaxAge != 30*time.Second || resp.Age != 10*time.Second {
+		t.Errorf("CacheMaxAge: %v, Age: %v, want 30s and 10s", resp.CacheMaxAge, resp.Age)
+	}
+	if resp.NextPollIn() != 20*time.Second {
+		t.Errorf("NextPollIn: %v, want 20s", resp.NextPollIn())
+	}
+	if resp.ETag != `W/"abc"` {
+		t.Errorf("ETag: %v", resp.ETag)
+	}
+	if resp.Date.IsZero() {
+		t.Error("Expected Date to be parsed")
+	}
+	if resp.Page != 1 || resp.PerPage != 100 || resp.Total != 250 || resp.NextPage != 2 || resp.LastPage != 3 {
+		t.Errorf("Unexpected pagination: page %d, per page %d, total %d, next %d, last %d",
+			resp.Page, resp.PerPage, resp.Total, resp.NextPage, resp.LastPage)
+	}
+}
+
+func TestResponse_LinkHeader(t *testing.T) {
+	r := &http.Response{Header: http.Header{}}
+	r.Header.Set("Link", `<https://api.coingecko.com/api/v3/coins/bitcoin/tickers?page=3>; rel="next", <https://api.coingecko.com/api/v3/coins/bitcoin/tickers?page=7>; rel="last"`)
+	resp := newResponse(r)
+	if resp.NextPage != 3 || resp.LastPage != 7 {
+		t.Errorf("NextPage: %d, LastPage: %d, want 3 and 7", resp.NextPage, resp.LastPage)
+	}
+}
@@ -0,0 +1,129 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures automatic retries in Client.Do.
+// Only idempotent requests (GET and HEAD) are retried, and only when CoinGecko
+// answers 429 Too Many Requests or a 5xx status, or the request failed in transit.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// Delay before the first retry. It doubles on every further retry.
+	BaseDelay time.Duration
+
+	// Upper bound for the backoff delay. Zero means no bound.
+	// A Retry-After header sent by CoinGecko takes precedence over the backoff delay.
+	MaxDelay time.Duration
+
+	// Fraction of the backoff delay, between 0 and 1, which is randomized
+	// to spread out retries of concurrent clients.
+	Jitter float64
+
+	// OnAttempt, if set, is called after every attempt with its outcome.
+	OnAttempt func(RetryAttempt)
+}
+
+// RetryAttempt is the outcome of a single attempt of a request
+type RetryAttempt struct {
+	// Attempt number, starting at 1
+	Attempt int
+
+	Request  *http.Request
+	Response *http.Response
+	Err      error
+
+	// Whether the request will be retried, and the delay before it is
+	Retry bool
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with 3 attempts and a backoff starting at one second.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Second,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// validate checks the policy for invalid values
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("retry policy max attempts must be at least 1, got %d", p.MaxAttempts)
+	}
+	if p.BaseDelay < 0 || p.MaxDelay < 0 {
+		return errors.New("retry policy delays must not be negative")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry policy jitter must be between 0 and 1, got %v", p.Jitter)
+	}
+	return nil
+}
+
+// retryable reports whether the outcome of an attempt warrants another attempt.
+func (p *RetryPolicy) retryable(req *http.Request, attempt int, err error) bool {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be rewound
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return errorResponse.StatusCode == http.StatusTooManyRequests || errorResponse.StatusCode >= 500
+	}
+	// Anything else is a transport error, unless the rate limiter gave up on the context
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// delay returns the delay before the given retry attempt, honoring the Retry-After header if present.
+func (p *RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); d > 0 {
+			return d
+		}
+	}
+
+	d := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		d -= d * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// sleepContext waits for d, or returns an error if ctx is done first
+// or its deadline would pass before d has elapsed.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(d).After(deadline) {
+		return context.DeadlineExceeded
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
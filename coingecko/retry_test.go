@@ -0,0 +1,110 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			fmt.Fprint(w, `{"gecko_says": "(V3) To the Moon!"}`)
+		}
+	})
+
+	var attempts []RetryAttempt
+	testClient.RetryPolicy = &RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Millisecond,
+		OnAttempt:   func(a RetryAttempt) { attempts = append(attempts, a) },
+	}
+
+	ping, _, err := testClient.Util.Ping()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if ping.GeckoSays == "" {
+		t.Error("Expected ping to be decoded")
+	}
+	if calls != 3 {
+		t.Errorf("Server calls: %d, want 3", calls)
+	}
+	if len(attempts) != 3 {
+		t.Fatalf("OnAttempt calls: %d, want 3", len(attempts))
+	}
+	if !attempts[0].Retry || !attempts[1].Retry || attempts[2].Retry {
+		t.Errorf("Unexpected retry decisions: %+v", attempts)
+	}
+}
+
+func TestClient_RetryPolicy_GivesUp(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	_, _, err := testClient.Util.Ping()
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 ErrorResponse, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Server calls: %d, want 2", calls)
+	}
+}
+
+func TestClient_RetryPolicy_NotFoundIsNotRetried(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	if _, _, err := testClient.Util.Ping(); err == nil {
+		t.Error("Expected error")
+	}
+	if calls != 1 {
+		t.Errorf("Server calls: %d, want 1", calls)
+	}
+}
+
+func TestClient_RetryPolicy_ContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, _, err := testClient.Util.PingWithContext(ctx); err == nil {
+		t.Error("Expected error")
+	}
+	if calls != 1 {
+		t.Errorf("Server calls: %d, want 1", calls)
+	}
+}
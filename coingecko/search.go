@@ -0,0 +1,192 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// SearchService handles the Search endpoints for CoinGecko API
+type SearchService struct {
+	client *Client
+}
+
+// SearchResult are the coins, exchanges, categories and NFT collections matching a search query
+type SearchResult struct {
+	Coins      []SearchCoin     `json:"coins"`
+	Exchanges  []SearchExchange `json:"exchanges"`
+	Categories []SearchCategory `json:"categories"`
+	NFTs       []SearchNFT      `json:"nfts"`
+}
+
+// SearchCoin is a coin matching a search query
+type SearchCoin struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	APISymbol     string `json:"api_symbol"`
+	Symbol        string `json:"symbol"`
+	MarketCapRank *int   `json:"market_cap_rank"`
+	Thumb         string `json:"thumb"`
+	Large         string `json:"large"`
+}
+
+// SearchExchange is an exchange matching a search query
+type SearchExchange struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	MarketType string `json:"market_type"`
+	Thumb      string `json:"thumb"`
+	Large      string `json:"large"`
+}
+
+// SearchCategory is a category matching a search query
+type SearchCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SearchNFT is an NFT collection matching a search query
+type SearchNFT struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	Thumb  string `json:"thumb"`
+}
+
+// Trending are the coins, NFT collections and categories searched the most on CoinGecko in the last 24 hours
+type Trending struct {
+	Coins      []TrendingCoin     `json:"coins"`
+	NFTs       []TrendingNFT      `json:"nfts"`
+	Categories []TrendingCategory `json:"categories"`
+}
+
+// TrendingCoin is a trending coin
+type TrendingCoin struct {
+	Item struct {
+		ID            string      `json:"id"`
+		CoinID        int         `json:"coin_id"`
+		Name          string      `json:"name"`
+		Symbol        string      `json:"symbol"`
+		MarketCapRank *int        `json:"market_cap_rank"`
+		Thumb         string      `json:"thumb"`
+		Small         string      `json:"small"`
+		Large         string      `json:"large"`
+		Slug          string      `json:"slug"`
+		PriceBTC      FlexFloat64 `json:"price_btc"`
+
+		// Position in the trending list, starting at 0
+		Score int `json:"score"`
+
+		Data struct {
+			Price                    FlexFloat64            `json:"price"`
+			PriceBTC                 FlexFloat64            `json:"price_btc"`
+			PriceChangePercentage24H map[string]FlexFloat64 `json:"price_change_percentage_24h"`
+
+			// Formatted for display, e.g. "$1,337,133,713"
+			MarketCap   string `json:"market_cap"`
+			TotalVolume string `json:"total_volume"`
+
+			// URL of a sparkline image of the last 7 days
+			Sparkline string `json:"sparkline"`
+		} `json:"data"`
+	} `json:"item"`
+}
+
+// TrendingNFT is a trending NFT collection
+type TrendingNFT struct {
+	ID                            string      `json:"id"`
+	Name                          string      `json:"name"`
+	Symbol                        string      `json:"symbol"`
+	Thumb                         string      `json:"thumb"`
+	NFTContractID                 int         `json:"nft_contract_id"`
+	NativeCurrencySymbol          string      `json:"native_currency_symbol"`
+	FloorPriceInNativeCurrency    FlexFloat64 `json:"floor_price_in_native_currency"`
+	FloorPrice24HPercentageChange FlexFloat64 `json:"floor_price_24h_percentage_change"`
+
+	Data struct {
+		// Formatted for display, e.g. "0.1 ETH"
+		FloorPrice          string `json:"floor_price"`
+		H24Volume           string `json:"h24_volume"`
+		H24AverageSalePrice string `json:"h24_average_sale_price"`
+
+		FloorPriceInUSD24HPercentageChange FlexFloat64 `json:"floor_price_in_usd_24h_percentage_change"`
+
+		// URL of a sparkline image of the last 7 days
+		Sparkline string `json:"sparkline"`
+	} `json:"data"`
+}
+
+// TrendingCategory is a trending category
+type TrendingCategory struct {
+	ID                int         `json:"id"`
+	Name              string      `json:"name"`
+	Slug              string      `json:"slug"`
+	CoinsCount        int         `json:"coins_count"`
+	MarketCap1HChange FlexFloat64 `json:"market_cap_1h_change"`
+
+	Data struct {
+		MarketCap                    FlexFloat64            `json:"market_cap"`
+		MarketCapBTC                 FlexFloat64            `json:"market_cap_btc"`
+		TotalVolume                  FlexFloat64            `json:"total_volume"`
+		TotalVolumeBTC               FlexFloat64            `json:"total_volume_btc"`
+		MarketCapChangePercentage24H map[string]FlexFloat64 `json:"market_cap_change_percentage_24h"`
+
+		// URL of a sparkline image of the last 7 days
+		Sparkline string `json:"sparkline"`
+	} `json:"data"`
+}
+
+// SearchWithContext searches for coins, exchanges, categories and NFT collections by name or symbol
+// https://api.coingecko.com/api/v3/search
+func (s *SearchService) SearchWithContext(ctx context.Context, query string) (*SearchResult, *Response, error) {
+	if len(query) == 0 {
+		return nil, nil, errors.New("search query is required")
+	}
+
+	urlValues := url.Values{}
+	urlValues.Add("query", query)
+
+	u := url.URL{
+		Path:     "/search",
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(SearchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// Search wraps SearchWithContext using the background context
+func (s *SearchService) Search(query string) (*SearchResult, *Response, error) {
+	return s.SearchWithContext(context.Background(), query)
+}
+
+// TrendingWithContext gets the trending coins, NFT collections and categories
+// https://api.coingecko.com/api/v3/search/trending
+func (s *SearchService) TrendingWithContext(ctx context.Context) (*Trending, *Response, error) {
+	apiEndpoint := "/search/trending"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trending := new(Trending)
+	resp, err := s.client.Do(req, trending)
+	if err != nil {
+		return nil, resp, err
+	}
+	return trending, resp, nil
+}
+
+// Trending wraps TrendingWithContext using the background context
+func (s *SearchService) Trending() (*Trending, *Response, error) {
+	return s.TrendingWithContext(context.Background())
+}
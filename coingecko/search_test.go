@@ -0,0 +1,80 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/search?query=bitcoin+cash")
+		fmt.Fprint(w, `{
+			"coins": [{"id": "bitcoin-cash", "name": "Bitcoin Cash", "api_symbol": "bitcoin-cash", "symbol": "BCH", "market_cap_rank": 17}],
+			"exchanges": [{"id": "bitcoin_com", "name": "Bitcoin.com Exchange", "market_type": "spot"}],
+			"icos": [],
+			"categories": [{"id": "bitcoin-cash-ecosystem", "name": "Bitcoin Cash Ecosystem"}],
+			"nfts": [{"id": "bitcoin-cash-punks", "name": "Bitcoin Cash Punks", "symbol": "BCP"}]
+		}`)
+	})
+
+	result, _, err := testClient.Search.Search("bitcoin cash")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Coins) != 1 || *result.Coins[0].MarketCapRank != 17 {
+		t.Errorf("Unexpected coins: %+v", result.Coins)
+	}
+	if len(result.Exchanges) != 1 || len(result.Categories) != 1 || len(result.NFTs) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	if _, _, err := testClient.Search.Search(""); err == nil {
+		t.Error("Expected error for empty query")
+	}
+}
+
+func TestSearchService_Trending(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/search/trending", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"coins": [{"item": {
+				"id": "bitcoin", "coin_id": 1, "name": "Bitcoin", "symbol": "BTC", "market_cap_rank": 1,
+				"price_btc": 1.0, "score": 0,
+				"data": {"price": 69000.5, "price_btc": "1.0", "price_change_percentage_24h": {"usd": 2.5}, "market_cap": "$1,357,000,000,000"}
+			}}],
+			"nfts": [{
+				"id": "pudgy-penguins", "name": "Pudgy Penguins", "symbol": "PPG", "native_currency_symbol": "eth",
+				"floor_price_in_native_currency": 12.5, "floor_price_24h_percentage_change": 1.5,
+				"data": {"floor_price": "12.50 ETH", "floor_price_in_usd_24h_percentage_change": "1.07"}
+			}],
+			"categories": [{
+				"id": 251, "name": "Solana Meme Coins", "slug": "solana-meme-coins", "coins_count": 79,
+				"data": {"market_cap": 8237562936.9, "market_cap_change_percentage_24h": {"usd": 14.2}}
+			}]
+		}`)
+	})
+
+	trending, _, err := testClient.Search.Trending()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(trending.Coins) != 1 || len(trending.NFTs) != 1 || len(trending.Categories) != 1 {
+		t.Fatalf("Unexpected trending: %+v", trending)
+	}
+	coin := trending.Coins[0].Item
+	if coin.ID != "bitcoin" || coin.Score != 0 || coin.Data.Price != 69000.5 || coin.Data.PriceBTC != 1 || coin.Data.PriceChangePercentage24H["usd"] != 2.5 {
+		t.Errorf("Unexpected coin: %+v", coin)
+	}
+	if nft := trending.NFTs[0]; nft.FloorPriceInNativeCurrency != 12.5 || nft.Data.FloorPriceInUSD24HPercentageChange != 1.07 {
+		t.Errorf("Unexpected nft: %+v", nft)
+	}
+	if category := trending.Categories[0]; category.ID != 251 || category.CoinsCount != 79 || category.Data.MarketCapChangePercentage24H["usd"] != 14.2 {
+		t.Errorf("Unexpected category: %+v", category)
+	}
+}
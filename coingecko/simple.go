@@ -0,0 +1,261 @@
+package coingecko
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// SimpleService handles the Simple endpoints for CoinGecko API
+type SimpleService struct {
+	client *Client
+}
+
+// SimplePriceOptions are the optional query parameters of the simple price endpoint
+type SimplePriceOptions struct {
+	IncludeMarketCap     bool `url:"include_market_cap,omitempty"`
+	Include24HrVol       bool `url:"include_24hr_vol,omitempty"`
+	Include24HrChange    bool `url:"include_24hr_change,omitempty"`
+	IncludeLastUpdatedAt bool `url:"include_last_updated_at,omitempty"`
+
+	// Decimal places of the prices, "0" to "18" or "full"
+	Precision string `url:"precision,omitempty"`
+}
+
+// SimplePrices maps coin ids to their prices
+type SimplePrices map[string]SimplePrice
+
+// SimplePrice is the price of a coin in the requested currencies.
+// The maps are keyed on the vs currency; MarketCap, Volume24H, Change24H and LastUpdatedAt
+// are only set if requested by the SimplePriceOptions.
+type SimplePrice struct {
+	Price         CurrencyPrice
+	MarketCap     CurrencyPrice
+	Volume24H     CurrencyPrice
+	Change24H     CurrencyPrice
+	LastUpdatedAt time.Time
+}
+
+// UnmarshalJSON decodes the flat CoinGecko representation, e.g.
+// {"usd": 1, "usd_market_cap": 2, "usd_24h_vol": 3, "usd_24h_change": 4, "last_updated_at": 1609459200}
+func (p *SimplePrice) UnmarshalJSON(data []byte) error {
+	var fields map[string]*float64
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*p = SimplePrice{Price: CurrencyPrice{}}
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+		switch {
+		case key == "last_updated_at":
+			p.LastUpdatedAt = time.Unix(int64(*value), 0).UTC()
+		case strings.HasSuffix(key, "_market_cap"):
+			p.MarketCap = setCurrencyPrice(p.MarketCap, strings.TrimSuffix(key, "_market_cap"), *value)
+		case strings.HasSuffix(key, "_24h_vol"):
+			p.Volume24H = setCurrencyPrice(p.Volume24H, strings.TrimSuffix(key, "_24h_vol"), *value)
+		case strings.HasSuffix(key, "_24h_change"):
+			p.Change24H = setCurrencyPrice(p.Change24H, strings.TrimSuffix(key, "_24h_change"), *value)
+		default:
+			p.Price[key] = *value
+		}
+	}
+	return nil
+}
+
+// setCurrencyPrice sets the price of currency, allocating the map if needed
+func setCurrencyPrice(prices CurrencyPrice, currency string, value float64) CurrencyPrice {
+	if prices == nil {
+		prices = CurrencyPrice{}
+	}
+	prices[currency] = value
+	return prices
+}
+
+// TokenPrices are the prices of tokens looked up by contract address
+type TokenPrices struct {
+	// Prices keyed on the lowercased contract address
+	Prices SimplePrices
+
+	// Lowercased contract addresses CoinGecko returned no price for
+	Missing []string
+}
+
+// maxContractAddressesLength is the maximum length of the encoded contract addresses
+// sent in a single token price request, to stay well under URL length limits.
+const maxContractAddressesLength = 1500
+
+// SupportedVsCurrencies is the list of currencies prices can be requested in
+type SupportedVsCurrencies []string
+
+// GetPriceWithContext gets the current price of coins in any other supported currencies
+// https://api.coingecko.com/api/v3/simple/price
+func (s *SimpleService) GetPriceWithContext(ctx context.Context, coinIDs []string, vsCurrencies []string, options *SimplePriceOptions) (SimplePrices, *Response, error) {
+	if len(coinIDs) == 0 {
+		return nil, nil, errors.New("coin ids are required")
+	}
+	if len(vsCurrencies) == 0 {
+		return nil, nil, errors.New("target currencies are required")
+	}
+
+	urlValues, err := simplePriceValues(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	urlValues.Set("ids", strings.Join(coinIDs, ","))
+	urlValues.Set("vs_currencies", strings.Join(vsCurrencies, ","))
+
+	u := url.URL{
+		Path:     "/simple/price",
+		RawQuery: urlValues.Encode(),
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prices := SimplePrices{}
+	resp, err := s.client.Do(req, &prices)
+	if err != nil {
+		return nil, resp, err
+	}
+	return prices, resp, nil
+}
+
+// GetPrice wraps GetPriceWithContext using the background context
+func (s *SimpleService) GetPrice(coinIDs []string, vsCurrencies []string, options *SimplePriceOptions) (SimplePrices, *Response, error) {
+	return s.GetPriceWithContext(context.Background(), coinIDs, vsCurrencies, options)
+}
+
+// GetTokenPriceWithContext gets the current price of tokens by contract address on an asset platform.
+// Long lists of contract addresses are split over several requests and the results merged.
+// The Response returned is the one of the last request.
+// https://api.coingecko.com/api/v3/simple/token_price/{id}
+func (s *SimpleService) GetTokenPriceWithContext(ctx context.Context, platformID string, contractAddresses []string, vsCurrencies []string, options *SimplePriceOptions) (*TokenPrices, *Response, error) {
+	if len(platformID) == 0 {
+		return nil, nil, errors.New("asset platform id is required")
+	}
+	chunks := chunkContractAddresses(contractAddresses, maxContractAddressesLength)
+	if len(chunks) == 0 {
+		return nil, nil, errors.New("contract addresses are required")
+	}
+	if len(vsCurrencies) == 0 {
+		return nil, nil, errors.New("target currencies are required")
+	}
+
+	urlValues, err := simplePriceValues(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	urlValues.Set("vs_currencies", strings.Join(vsCurrencies, ","))
+
+	tokenPrices := &TokenPrices{Prices: SimplePrices{}}
+	var resp *Response
+	for _, chunk := range chunks {
+		urlValues.Set("contract_addresses", strings.Join(chunk, ","))
+		u := url.URL{
+			Path:     "/simple/token_price/" + platformID,
+			RawQuery: urlValues.Encode(),
+		}
+
+		req, err := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		prices := SimplePrices{}
+		resp, err = s.client.Do(req, &prices)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for address, price := range prices {
+			tokenPrices.Prices[strings.ToLower(address)] = price
+		}
+		for _, address := range chunk {
+			if _, ok := tokenPrices.Prices[strings.ToLower(address)]; !ok {
+				tokenPrices.Missing = append(tokenPrices.Missing, strings.ToLower(address))
+			}
+		}
+	}
+	return tokenPrices, resp, nil
+}
+
+// GetTokenPrice wraps GetTokenPriceWithContext using the background context
+func (s *SimpleService) GetTokenPrice(platformID string, contractAddresses []string, vsCurrencies []string, options *SimplePriceOptions) (*TokenPrices, *Response, error) {
+	return s.GetTokenPriceWithContext(context.Background(), platformID, contractAddresses, vsCurrencies, options)
+}
+
+// GetSupportedVsCurrenciesWithContext gets the list of supported vs currencies
+// https://api.coingecko.com/api/v3/simple/supported_vs_currencies
+func (s *SimpleService) GetSupportedVsCurrenciesWithContext(ctx context.Context) (SupportedVsCurrencies, *Response, error) {
+	apiEndpoint := "/simple/supported_vs_currencies"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var currencies SupportedVsCurrencies
+	resp, err := s.client.Do(req, &currencies)
+	if err != nil {
+		return nil, resp, err
+	}
+	return currencies, resp, nil
+}
+
+// GetSupportedVsCurrencies wraps GetSupportedVsCurrenciesWithContext using the background context
+func (s *SimpleService) GetSupportedVsCurrencies() (SupportedVsCurrencies, *Response, error) {
+	return s.GetSupportedVsCurrenciesWithContext(context.Background())
+}
+
+// simplePriceValues encodes the options of the simple price endpoints
+func simplePriceValues(options *SimplePriceOptions) (url.Values, error) {
+	if options == nil {
+		return url.Values{}, nil
+	}
+	return query.Values(options)
+}
+
+// chunkContractAddresses splits the contract addresses in chunks whose
+// comma separated, query escaped form is at most maxLength long.
+// Duplicate addresses, compared case insensitively, are dropped.
+func chunkContractAddresses(addresses []string, maxLength int) [][]string {
+	seen := make(map[string]bool, len(addresses))
+	var chunks [][]string
+	var chunk []string
+	length := 0
+	for _, address := range addresses {
+		address = strings.TrimSpace(address)
+		key := strings.ToLower(address)
+		if len(address) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		addressLength := len(url.QueryEscape(address))
+		if len(chunk) > 0 {
+			// Separating comma, escaped as %2C
+			addressLength += 3
+		}
+		if len(chunk) > 0 && length+addressLength > maxLength {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			length = 0
+			addressLength -= 3
+		}
+		chunk = append(chunk, address)
+		length += addressLength
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
@@ -0,0 +1,139 @@
+package coingecko
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSimpleService_GetPrice(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/simple/price", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		if q.Get("ids") != "bitcoin,ethereum" || q.Get("vs_currencies") != "usd,eur" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		if q.Get("include_market_cap") != "true" || q.Get("precision") != "full" {
+			t.Errorf("Unexpected options: %v", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{
+			"bitcoin": {"usd": 50000, "usd_market_cap": 900000000000, "eur": 42000, "eur_market_cap": 800000000000, "usd_24h_change": null, "last_updated_at": 1609459200},
+			"ethereum": {"usd": 4000, "eur": 3400}
+		}`)
+	})
+
+	prices, _, err := testClient.Simple.GetPrice([]string{"bitcoin", "ethereum"}, []string{"usd", "eur"}, &SimplePriceOptions{
+		IncludeMarketCap: true,
+		Precision:        "full",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	bitcoin := prices["bitcoin"]
+	if bitcoin.Price["usd"] != 50000 || bitcoin.Price["eur"] != 42000 {
+		t.Errorf("Unexpected prices: %+v", bitcoin.Price)
+	}
+	if bitcoin.MarketCap["usd"] != 900000000000 {
+		t.Errorf("Unexpected market caps: %+v", bitcoin.MarketCap)
+	}
+	if bitcoin.Change24H != nil {
+		t.Errorf("Expected null 24h change to be skipped, got %+v", bitcoin.Change24H)
+	}
+	if !bitcoin.LastUpdatedAt.Equal(time.Unix(1609459200, 0)) {
+		t.Errorf("LastUpdatedAt: %v", bitcoin.LastUpdatedAt)
+	}
+	if len(prices["ethereum"].Price) != 2 {
+		t.Errorf("Unexpected ethereum prices: %+v", prices["ethereum"])
+	}
+}
+
+func TestSimpleService_GetPrice_Required(t *testing.T) {
+	c, _ := NewClient()
+	if _, _, err := c.Simple.GetPrice(nil, []string{"usd"}, nil); err == nil {
+		t.Error("Expected error for missing coin ids")
+	}
+	if _, _, err := c.Simple.GetPrice([]string{"bitcoin"}, nil, nil); err == nil {
+		t.Error("Expected error for missing vs currencies")
+	}
+}
+
+func TestSimpleService_GetSupportedVsCurrencies(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/simple/supported_vs_currencies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `["btc", "eth", "usd"]`)
+	})
+
+	currencies, _, err := testClient.Simple.GetSupportedVsCurrencies()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(currencies) != 3 || currencies[2] != "usd" {
+		t.Errorf("Unexpected currencies: %v", currencies)
+	}
+}
+
+func TestSimpleService_GetTokenPrice(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/simple/token_price/ethereum", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if r.URL.Query().Get("vs_currencies") != "usd" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48": {"usd": 1.001}}`)
+	})
+
+	addresses := []string{"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", "0xdac17f958d2ee523a2206206994597c13d831ec7"}
+	for i := 0; i < 60; i++ {
+		addresses = append(addresses, fmt.Sprintf("0x%040d", i))
+	}
+
+	tokenPrices, _, err := testClient.Simple.GetTokenPrice("ethereum", addresses, []string{"usd"}, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if calls < 2 {
+		t.Errorf("Expected long address list to be split over several requests, got %d", calls)
+	}
+	if got := tokenPrices.Prices["0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"].Price["usd"]; got != 1.001 {
+		t.Errorf("Unexpected USDC price: %v", got)
+	}
+	if len(tokenPrices.Missing) != len(addresses)-1 {
+		t.Errorf("Missing: %d addresses, want %d", len(tokenPrices.Missing), len(addresses)-1)
+	}
+	if tokenPrices.Missing[0] != "0xdac17f958d2ee523a2206206994597c13d831ec7" {
+		t.Errorf("Unexpected first missing address: %v", tokenPrices.Missing[0])
+	}
+}
+
+func TestSimpleService_GetTokenPrice_Required(t *testing.T) {
+	c, _ := NewClient()
+	for _, addresses := range [][]string{nil, {" "}, {"", "  "}} {
+		if _, _, err := c.Simple.GetTokenPrice("ethereum", addresses, []string{"usd"}, nil); err == nil {
+			t.Errorf("Expected error for contract addresses %q", addresses)
+		}
+	}
+	if _, _, err := c.Simple.GetTokenPrice("", []string{"0xabc"}, []string{"usd"}, nil); err == nil {
+		t.Error("Expected error for missing asset platform id")
+	}
+}
+
+func TestChunkContractAddresses(t *testing.T) {
+	chunks := chunkContractAddresses([]string{"aaaa", "AAAA", "bbbb", "cccc", " ", "dddd"}, 11)
+	if len(chunks) != 2 {
+		t.Fatalf("Chunks: %v, want 2 chunks", chunks)
+	}
+	if len(chunks[0]) != 2 || chunks[0][0] != "aaaa" || chunks[0][1] != "bbbb" {
+		t.Errorf("Unexpected first chunk: %v", chunks[0])
+	}
+	if len(chunks[1]) != 2 || chunks[1][1] != "dddd" {
+		t.Errorf("Unexpected second chunk: %v", chunks[1])
+	}
+}
@@ -2,7 +2,6 @@ package coingecko
 
 import (
 	"context"
-	"net/http"
 )
 
 // UtilService handles utility for CoinGecko API
@@ -17,7 +16,7 @@ type Ping struct {
 
 // Check CoinGecko API server status
 // https://api.coingecko.com/api/v3/ping
-func (s *UtilService) PingWithContext(ctx context.Context) (*Ping, *http.Response, error) {
+func (s *UtilService) PingWithContext(ctx context.Context) (*Ping, *Response, error) {
 	apiEndpoint := "/ping"
 	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
@@ -33,6 +32,6 @@ func (s *UtilService) PingWithContext(ctx context.Context) (*Ping, *http.Respons
 }
 
 // Ping wraps PingWithContext using the background context.
-func (s *UtilService) Ping() (*Ping, *http.Response, error) {
+func (s *UtilService) Ping() (*Ping, *Response, error) {
 	return s.PingWithContext(context.Background())
 }